@@ -20,15 +20,39 @@ type Config struct {
 	BizOut         string         // Biz 层输出目录 (e.g. "internal/biz")
 	ServiceOut     string         // Service 层输出目录 (e.g. "internal/service")
 	DataOut        string         // Data 层输出目录 (e.g. "internal/data")
-	SingleFile     bool           // 是否启用单文件生成模式
+	SingleFile     bool           // 是否启用单文件生成模式，WithCRUDService 需要启用
 	ProtoValidator ProtoValidator // Proto 校验器类型
 
+	// DefaultEdgeStrategy 全局默认 Edge 策略，优先级低于 Edge 与 Schema 上的注解
+	// 也可以通过 entc.Annotations(lazyent.Annotation{...}) 设置全局默认注解
+	DefaultEdgeStrategy EdgeFieldStrategy
+
+	// KeepSensitiveInBiz 在 Biz 层及 Ent <-> Biz 映射中保留 Sensitive 字段 (Proto 中仍然移除)
+	// 并为包含 Sensitive 字段的实体生成 Redacted() 方法
+	KeepSensitiveInBiz bool
+
+	// ErrorReasons 生成 errors_gen.proto，其中 ErrorReason 枚举为每个 Schema 及其唯一约束声明 Kratos 错误原因 (errors.code)，
+	// 例如 USER_NOT_FOUND、GROUP_NAME_CONFLICT；Data 层仓储将 ent 的 NotFound、约束与校验错误转换为对应的 Kratos 错误
+	ErrorReasons bool
+
+	// WireProviders 在 Biz、Data 与 Service 层生成 wire_gen_providers.go，其中 ProviderSet 包含生成的用例、仓储与服务构造函数，
+	// Service 层还以生成的用例提供各服务依赖的 XUsecase 接口 (未启用 WithRepo 的 CRUD 服务需自行提供)，新增 Schema 时无需手动修改各层的 ProviderSet
+	WireProviders bool
+
 	// Optional configuration
-	BizBaseFileName    string
-	BizEntityFileName  string
-	SvcMapperFileName  string
-	DataMapperFileName string
-	ProtoFileName      string
+	BizBaseFileName          string
+	BizEntityFileName        string
+	BizRepoFileName          string // 仓储接口文件，仅在存在 WithRepo 的 Schema 时生成
+	BizRepoScaffoldFileName  string // 仓储扩展接口脚手架，仅生成一次
+	BizUsecaseFileName       string // 用例基础实现文件，仅在存在 WithRepo 的 Schema 时生成，用例脚手架按 Schema 生成为 <schema>_usecase.go
+	SvcMapperFileName        string
+	SvcServiceFileName       string // CRUD 服务的用例接口与请求/响应转换，服务实现脚手架按 Schema 生成为 <schema>_service.go
+	DataRepoFileName         string // 仓储实现文件，仅在存在 WithRepo 的 Schema 时生成
+	DataRepoScaffoldFileName string // 仓储构造函数与扩展方法脚手架，仅生成一次
+	DataMapperFileName       string
+	ProtoFileName            string
+	ErrorProtoFileName       string // ErrorReason 枚举文件，仅在启用 ErrorReasons 时生成
+	WireFileName             string // 各层的 Wire 提供者集合文件，仅在启用 WireProviders 时生成
 }
 
 func NewExtension(cfg Config) *Extension {
@@ -44,8 +68,14 @@ func (e *Extension) Hooks() []gen.Hook {
 	}
 }
 
+// Annotations 将 Config 中的全局默认值注入为 entc 全局注解
 func (e *Extension) Annotations() []entc.Annotation {
-	return nil
+	if e.conf.DefaultEdgeStrategy == BizPointerWithProtoMessage {
+		return nil
+	}
+	return []entc.Annotation{
+		WithEdgeFieldStrategy(e.conf.DefaultEdgeStrategy),
+	}
 }
 
 func (e *Extension) Options() []entc.Option {
@@ -63,19 +93,30 @@ func (e *Extension) GenerateFiles(next gen.Generator) gen.Generator {
 		}
 		// Convert config to internal config
 		iConf := lg.Config{
-			ProtoOut:           e.conf.ProtoOut,
-			ProtoPackage:       e.conf.ProtoPackage,
-			GoPackage:          e.conf.GoPackage,
-			BizOut:             e.conf.BizOut,
-			ServiceOut:         e.conf.ServiceOut,
-			DataOut:            e.conf.DataOut,
-			SingleFile:         e.conf.SingleFile,
-			BizBaseFileName:    e.conf.BizBaseFileName,
-			BizEntityFileName:  e.conf.BizEntityFileName,
-			SvcMapperFileName:  e.conf.SvcMapperFileName,
-			DataMapperFileName: e.conf.DataMapperFileName,
-			ProtoFileName:      e.conf.ProtoFileName,
-			ProtoValidator:     e.conf.ProtoValidator,
+			ProtoOut:                 e.conf.ProtoOut,
+			ProtoPackage:             e.conf.ProtoPackage,
+			GoPackage:                e.conf.GoPackage,
+			BizOut:                   e.conf.BizOut,
+			ServiceOut:               e.conf.ServiceOut,
+			DataOut:                  e.conf.DataOut,
+			SingleFile:               e.conf.SingleFile,
+			BizBaseFileName:          e.conf.BizBaseFileName,
+			BizEntityFileName:        e.conf.BizEntityFileName,
+			BizRepoFileName:          e.conf.BizRepoFileName,
+			BizRepoScaffoldFileName:  e.conf.BizRepoScaffoldFileName,
+			BizUsecaseFileName:       e.conf.BizUsecaseFileName,
+			SvcMapperFileName:        e.conf.SvcMapperFileName,
+			SvcServiceFileName:       e.conf.SvcServiceFileName,
+			DataRepoFileName:         e.conf.DataRepoFileName,
+			DataRepoScaffoldFileName: e.conf.DataRepoScaffoldFileName,
+			DataMapperFileName:       e.conf.DataMapperFileName,
+			ProtoFileName:            e.conf.ProtoFileName,
+			ProtoValidator:           e.conf.ProtoValidator,
+			KeepSensitiveInBiz:       e.conf.KeepSensitiveInBiz,
+			ErrorReasons:             e.conf.ErrorReasons,
+			ErrorProtoFileName:       e.conf.ErrorProtoFileName,
+			WireProviders:            e.conf.WireProviders,
+			WireFileName:             e.conf.WireFileName,
 		}
 
 		return lg.Generate(iConf, g)
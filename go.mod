@@ -5,9 +5,15 @@ go 1.25.4
 require (
 	entgo.io/ent v0.14.5
 	github.com/envoyproxy/protoc-gen-validate v1.3.0
+	github.com/go-kratos/kratos/v2 v2.8.3
 	github.com/google/go-cmp v0.7.0
-	github.com/google/uuid v1.3.0
+	github.com/google/uuid v1.6.0
+	github.com/google/wire v0.6.0
+	github.com/mattn/go-sqlite3 v1.14.17
 	golang.org/x/mod v0.31.0
+	golang.org/x/tools v0.39.0
+	google.golang.org/genproto/googleapis/api v0.0.0-20250106144421-5f5ef82da422
+	google.golang.org/grpc v1.69.4
 	google.golang.org/protobuf v1.36.11
 )
 
@@ -23,7 +29,9 @@ require (
 	github.com/stretchr/testify v1.11.1 // indirect
 	github.com/zclconf/go-cty v1.14.4 // indirect
 	github.com/zclconf/go-cty-yaml v1.1.0 // indirect
+	golang.org/x/net v0.47.0 // indirect
 	golang.org/x/sync v0.18.0 // indirect
-	golang.org/x/text v0.22.0 // indirect
-	golang.org/x/tools v0.39.0 // indirect
+	golang.org/x/sys v0.38.0 // indirect
+	golang.org/x/text v0.31.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250102185135-69823020774d // indirect
 )
@@ -0,0 +1,120 @@
+package gen
+
+import (
+	entgen "entgo.io/ent/entc/gen"
+	types "github.com/Cromemadnd/lazyent/internal/types"
+)
+
+// AssocDef describes an association type generated for an M2M edge that goes
+// through an edge schema (edge.Through), e.g. GroupMembership{User *User; Role ...}.
+type AssocDef struct {
+	Name     string                  // Association type name, e.g. GroupMembership
+	NodeName string                  // Node that declares the edge, e.g. Group
+	Edge     *entgen.Edge            // Auto-generated edge to the edge schema, e.g. Group.memberships
+	Target   *entgen.Edge            // Edge schema edge to the other side, e.g. Membership.user
+	Fields   []*entgen.Field         // Payload fields of the edge schema
+	Strategy types.EdgeFieldStrategy // How Target is represented (taken from the M2M edge)
+}
+
+func (a AssocDef) Schema() string { return a.Edge.Type.Name }
+
+func (a AssocDef) TargetName() string { return a.Target.Type.Name }
+
+func (a AssocDef) BizPointer() bool {
+	return a.Strategy == types.BizPointerWithProtoMessage || a.Strategy == types.BizPointerWithProtoID || a.Strategy == types.BizPointerWithProtoExclude
+}
+
+func (a AssocDef) BizID() bool {
+	return a.Strategy == types.BizIDWithProtoID || a.Strategy == types.BizIDWithProtoExclude
+}
+
+func (a AssocDef) ProtoMessage() bool {
+	return a.Strategy == types.BizPointerWithProtoMessage
+}
+
+func (a AssocDef) ProtoID() bool {
+	return a.Strategy == types.BizPointerWithProtoID || a.Strategy == types.BizIDWithProtoID
+}
+
+func (a AssocDef) ProtoExclude() bool {
+	return !a.ProtoMessage() && !a.ProtoID()
+}
+
+func (a AssocDef) BizField() string {
+	if a.BizID() {
+		if f := a.Target.Field(); f != nil {
+			return bizFieldName(f)
+		}
+		return a.Target.StructField() + "ID"
+	}
+	return a.Target.StructField()
+}
+
+func (a AssocDef) ProtoField() string {
+	if a.ProtoID() {
+		return a.Target.Name + "_id"
+	}
+	return a.Target.Name
+}
+
+func (a AssocDef) TargetIDField() *entgen.Field {
+	return a.Target.Field()
+}
+
+func newAssocDef(e *entgen.Edge, keepSensitive bool) (AssocDef, bool) {
+	m := throughM2M(e)
+	if m == nil {
+		return AssocDef{}, false
+	}
+	a := AssocDef{
+		Name:     edgeTypeName(e),
+		NodeName: edgeNode(e).Name,
+		Edge:     e,
+		Strategy: annotatedStrategy(m),
+	}
+	for _, te := range e.Type.Edges {
+		if te.Type == m.Type && te != e.Ref && te.Field() != nil {
+			a.Target = te
+			break
+		}
+	}
+	if a.Target == nil {
+		return AssocDef{}, false
+	}
+	for _, f := range e.Type.Fields {
+		if f.IsEdgeField() || isFieldBizExclude(f, keepSensitive) {
+			continue
+		}
+		a.Fields = append(a.Fields, f)
+	}
+	return a, true
+}
+
+func getNodeAssociations(edges []*entgen.Edge, keepSensitive bool) []AssocDef {
+	var res []AssocDef
+	for _, e := range edges {
+		if isBizExclude(e) {
+			continue
+		}
+		if a, ok := newAssocDef(e, keepSensitive); ok {
+			res = append(res, a)
+		}
+	}
+	return res
+}
+
+func getAllAssociations(nodes []interface{}, keepSensitive bool) []AssocDef {
+	var res []AssocDef
+	for _, node := range nodes {
+		n, ok := node.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		edges, ok := n["Edges"].([]*entgen.Edge)
+		if !ok {
+			continue
+		}
+		res = append(res, getNodeAssociations(edges, keepSensitive)...)
+	}
+	return res
+}
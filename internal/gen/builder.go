@@ -0,0 +1,231 @@
+package gen
+
+import (
+	"fmt"
+
+	entgen "entgo.io/ent/entc/gen"
+)
+
+func builderCreateFields(n *entgen.Type, keepSensitive bool) []*entgen.Field {
+	var res []*entgen.Field
+	for _, f := range n.Fields {
+		if isFieldBizExclude(f, keepSensitive) {
+			continue
+		}
+		res = append(res, f)
+	}
+	return res
+}
+
+// builderUpdateFields returns the fields set by BuildXUpdate: fields kept in
+// biz that are neither Immutable, refreshed by ent itself (UpdateDefault) nor
+// managed by the repository (version, soft-delete and tenant fields).
+func builderUpdateFields(n *entgen.Type, keepSensitive bool) []*entgen.Field {
+	var res []*entgen.Field
+	for _, f := range builderCreateFields(n, keepSensitive) {
+		if f.Immutable || f.UpdateDefault || isVersionField(f) || isSoftDeleteField(f) || isTenantField(f) {
+			continue
+		}
+		res = append(res, f)
+	}
+	return res
+}
+
+// builderEdges returns the edges whose IDs are wired by BuildXCreate/BuildXUpdate.
+// Edges backed by an edge-field are set through the field, and edge schema
+// edges through their association. Updates skip Immutable edges and those
+// required by their targets.
+func builderEdges(n *entgen.Type, update bool) []*entgen.Edge {
+	var res []*entgen.Edge
+	for _, e := range n.Edges {
+		if isBizExclude(e) || e.Field() != nil || e.Through != nil || isThroughEdge(e) {
+			continue
+		}
+		if update && (e.Immutable || requiredByTarget(e)) {
+			continue
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+// hasEdgeSchemaEdges reports whether a node has edges through an edge schema,
+// which BuildXCreate/BuildXUpdate leave to the edge schema entities.
+func hasEdgeSchemaEdges(n *entgen.Type) bool {
+	for _, e := range n.Edges {
+		if !isBizExclude(e) && (e.Through != nil || isThroughEdge(e)) {
+			return true
+		}
+	}
+	return false
+}
+
+// bizZeroCond returns the condition telling whether the biz value expr of a
+// field is zero (or non-zero if zero is false), or "" if the biz type has no
+// comparable zero value.
+func bizZeroCond(f *entgen.Field, expr string, zero bool) string {
+	cmp := func(z string) string {
+		if zero {
+			return fmt.Sprintf("%s == %s", expr, z)
+		}
+		return fmt.Sprintf("%s != %s", expr, z)
+	}
+	if isSlice(f) {
+		if zero {
+			return fmt.Sprintf("len(%s) == 0", expr)
+		}
+		return fmt.Sprintf("len(%s) > 0", expr)
+	}
+	if f.IsEnum() {
+		if isExternalEnum(f) {
+			return cmp(`""`)
+		}
+		return ""
+	}
+	switch bizFieldType(f) {
+	case "string":
+		return cmp(`""`)
+	case "bool":
+		if zero {
+			return "!" + expr
+		}
+		return expr
+	case "time.Time":
+		if zero {
+			return expr + ".IsZero()"
+		}
+		return "!" + expr + ".IsZero()"
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64",
+		"float32", "float64":
+		return cmp("0")
+	}
+	return ""
+}
+
+// setFieldFromBiz generates the statements calling the setter of a field on
+// the builder recv with its biz value. ret prefixes the error returned on
+// invalid values, e.g. "nil, ".
+func setFieldFromBiz(f *entgen.Field, nodeName, recv, ret string) string {
+	src := "b." + bizFieldName(f)
+	set := recv + "." + f.MutationSet()
+	varName := camel(f.StructField()) + "Val"
+	if stmt := parseFieldFromBiz(f, nodeName, src, varName, ret); stmt != "" {
+		return fmt.Sprintf("%s\n%s(%s)", stmt, set, varName)
+	}
+	return fmt.Sprintf("%s(%s)", set, convertBizToEntValue(f, nodeName, src))
+}
+
+// parseFieldFromBiz generates the statements parsing the biz string src of a
+// UUID or time field of entity into varName, or returns "" if the biz value
+// converts without error.
+func parseFieldFromBiz(f *entgen.Field, entity, src, varName, ret string) string {
+	switch {
+	case f.Type.String() == "uuid.UUID" && (explicitBizType(f) == "" || explicitBizType(f) == "string"):
+		return fmt.Sprintf("%s, err := uuid.Parse(%s)\nif err != nil {\n\treturn %s%s\n}",
+			varName, src, ret, invalidArgumentErr(entity, "invalid UUID for "+f.Name))
+	case f.Type.String() == "time.Time" && explicitBizType(f) == "string":
+		return fmt.Sprintf("%s, err := time.Parse(time.RFC3339, %s)\nif err != nil {\n\treturn %s%s\n}",
+			varName, src, ret, invalidArgumentErr(entity, "invalid Time format for "+f.Name))
+	}
+	return ""
+}
+
+// createFieldFromBiz generates the statements setting a field on the create
+// builder. Zero values of Optional and defaulted fields are treated as unset,
+// leaving the column NULL or to its ent default. Bools are always set.
+func createFieldFromBiz(f *entgen.Field, nodeName string) string {
+	stmt := setFieldFromBiz(f, nodeName, "m", "nil, ")
+	if !f.Optional && !f.Default {
+		return stmt
+	}
+	cond := bizZeroCond(f, "b."+bizFieldName(f), false)
+	if cond == "" || bizFieldType(f) == "bool" {
+		return stmt
+	}
+	return fmt.Sprintf("if %s {\n%s\n}", cond, stmt)
+}
+
+// updateFieldFromBiz generates the statements setting a field on the update
+// builder. Optional fields holding zero values are cleared, other fields
+// parsed from zero values (e.g. an empty UUID string) are left unchanged.
+func updateFieldFromBiz(f *entgen.Field, nodeName string) string {
+	return updateFieldSetter(f, nodeName, "nil, ")
+}
+
+func updateFieldSetter(f *entgen.Field, nodeName, ret string) string {
+	stmt := setFieldFromBiz(f, nodeName, "u", ret)
+	if !f.Optional {
+		if parseFieldFromBiz(f, "", "", "", "") == "" {
+			return stmt
+		}
+		if cond := bizZeroCond(f, "b."+bizFieldName(f), false); cond != "" {
+			return fmt.Sprintf("if %s {\n%s\n}", cond, stmt)
+		}
+		return stmt
+	}
+	cond := bizZeroCond(f, "b."+bizFieldName(f), true)
+	if cond == "" {
+		return stmt
+	}
+	return fmt.Sprintf("if %s {\n\tu.%s()\n} else {\n%s\n}", cond, f.MutationClear(), stmt)
+}
+
+// requiredByTarget reports whether the targets of an O2M edge require their
+// inverse edge, e.g. User.posts when Post.author is Required. Such edges are
+// only updated from the target side, as clearing them would orphan the targets.
+func requiredByTarget(e *entgen.Edge) bool {
+	return e.O2M() && e.Ref != nil && !e.Ref.Optional
+}
+
+// edgeIDsFromBiz generates the statements collecting the ent IDs of a
+// non-unique edge from the biz entity into varName.
+func edgeIDsFromBiz(e *entgen.Edge, varName, ret string) string {
+	item, skip := "item", ""
+	if !isBizIDOnly(e) {
+		item, skip = "item.UUID", "if item == nil {\n\tcontinue\n}\n"
+	}
+	return fmt.Sprintf("%s := make([]%s, 0, len(b.%s))\nfor _, item := range b.%s {\n%s%s\n%s = append(%s, id)\n}",
+		varName, e.Type.ID.Type.String(), bizEdgeName(e), bizEdgeName(e), skip, entIDFromBiz(e.Type.ID, edgeNode(e).Name, item, "id", ret), varName, varName)
+}
+
+func edgeIDsVar(e *entgen.Edge) string {
+	return camel(entgen.Funcs["singular"].(func(string) string)(e.StructField())) + "IDs"
+}
+
+// createEdgeFromBiz generates the statements wiring the edge IDs held by the
+// biz entity on the create builder.
+func createEdgeFromBiz(e *entgen.Edge) string {
+	src := "b." + bizEdgeName(e)
+	switch {
+	case !e.Unique:
+		return fmt.Sprintf("if len(%s) > 0 {\n%s\nm.%s(ids...)\n}", src, edgeIDsFromBiz(e, "ids", "nil, "), e.MutationAdd())
+	case isBizIDOnly(e):
+		return fmt.Sprintf("if %s != %s {\n\tm.%s(%s)\n}", src, zeroValue(edgeIDType(e)), e.MutationSet(), src)
+	}
+	return fmt.Sprintf("if %s != nil {\n%s\nm.%s(id)\n}", src, entIDFromBiz(e.Type.ID, edgeNode(e).Name, src+".UUID", "id", "nil, "), e.MutationSet())
+}
+
+// updateEdgeFromBiz generates the statements replacing the edge IDs on the
+// update builder with those held by the biz entity.
+func updateEdgeFromBiz(e *entgen.Edge) string {
+	return updateEdgeSetter(e, "nil, ")
+}
+
+func updateEdgeSetter(e *entgen.Edge, ret string) string {
+	src := "b." + bizEdgeName(e)
+	switch {
+	case !e.Unique:
+		ids := edgeIDsVar(e)
+		return fmt.Sprintf("%s\nu.%s().%s(%s...)", edgeIDsFromBiz(e, ids, ret), e.MutationClear(), e.MutationAdd(), ids)
+	}
+	// Required edges are never cleared, an unset one is left unchanged.
+	zero, set := "nil", fmt.Sprintf("%s\nu.%s(id)", entIDFromBiz(e.Type.ID, edgeNode(e).Name, src+".UUID", "id", ret), e.MutationSet())
+	if isBizIDOnly(e) {
+		zero, set = zeroValue(edgeIDType(e)), fmt.Sprintf("u.%s(%s)", e.MutationSet(), src)
+	}
+	if !e.Optional {
+		return fmt.Sprintf("if %s != %s {\n%s\n}", src, zero, set)
+	}
+	return fmt.Sprintf("if %s == %s {\n\tu.%s()\n} else {\n%s\n}", src, zero, e.MutationClear(), set)
+}
@@ -15,10 +15,21 @@ type Config struct {
 	SingleFile   bool   // Single file mode
 
 	// Optional configuration (Internal use)
-	BizBaseFileName    string
-	BizEntityFileName  string
-	SvcMapperFileName  string
-	DataMapperFileName string
-	ProtoFileName      string
-	ProtoValidator     types.ProtoValidator
+	BizBaseFileName          string
+	BizEntityFileName        string
+	BizRepoFileName          string
+	BizRepoScaffoldFileName  string
+	BizUsecaseFileName       string
+	SvcMapperFileName        string
+	SvcServiceFileName       string
+	DataRepoFileName         string
+	DataRepoScaffoldFileName string
+	DataMapperFileName       string
+	ProtoFileName            string
+	ProtoValidator           types.ProtoValidator
+	KeepSensitiveInBiz       bool // Keep Sensitive fields in biz and the Ent <-> Biz mappers
+	ErrorReasons             bool // Generate the ErrorReason proto enum and translate ent errors to it
+	ErrorProtoFileName       string
+	WireProviders            bool // Generate a Wire ProviderSet per layer
+	WireFileName             string
 }
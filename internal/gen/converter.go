@@ -70,6 +70,11 @@ func convertFromProto(f *entgen.Field, nodeName string) string {
 	if f == nil {
 		return ""
 	}
+	return convertFromProtoExpr(f, nodeName, "p."+protoGoName(f))
+}
+
+// convertFromProtoExpr converts the proto value expr of a field to its biz type.
+func convertFromProtoExpr(f *entgen.Field, nodeName string, expr string) string {
 	if isSensitive(f) {
 		return zeroValue(bizFieldType(f))
 	}
@@ -79,12 +84,12 @@ func convertFromProto(f *entgen.Field, nodeName string) string {
 
 	if f.IsEnum() {
 		if isExternalEnum(f) {
-			return fmt.Sprintf("%s(p.%s)", getExternalEnumName(f), protoGoName(f))
+			return fmt.Sprintf("%s(%s)", getExternalEnumName(f), expr)
 		}
-		return fmt.Sprintf("%s(p.%s)", enumFromProtoFuncName(f, nodeName), protoGoName(f))
+		return fmt.Sprintf("%s(%s)", enumFromProtoFuncName(f, nodeName), expr)
 	}
 	if f.Type.String() == "time.Time" {
-		return fmt.Sprintf("p.%s.AsTime()", protoGoName(f))
+		return expr + ".AsTime()"
 	}
 
 	targetType := bizFieldType(f)
@@ -94,28 +99,28 @@ func convertFromProto(f *entgen.Field, nodeName string) string {
 
 	if f.Type.String() == "uuid.UUID" {
 		if targetType == "string" {
-			return "p." + protoGoName(f)
+			return expr
 		}
 		// Uses MustParse which is dangerous. The Template should now use convertFromProtoSetup/Usage.
 		// If this is still called directly, we might Panic.
-		return fmt.Sprintf("uuid.MustParse(p.%s)", protoGoName(f))
+		return fmt.Sprintf("uuid.MustParse(%s)", expr)
 	}
 
 	if targetType == "string" {
-		return "p." + protoGoName(f)
+		return expr
 	}
 	if targetType == "bool" {
-		return "p." + protoGoName(f)
+		return expr
 	}
 
 	switch targetType {
 	case "int", "int8", "int16", "int32", "int64",
 		"uint", "uint8", "uint16", "uint32", "uint64",
 		"float32", "float64":
-		return fmt.Sprintf("%s(p.%s)", targetType, protoGoName(f))
+		return fmt.Sprintf("%s(%s)", targetType, expr)
 	}
 
-	return "p." + protoGoName(f)
+	return expr
 }
 
 func convertEntToBiz(f *entgen.Field, nodeName string, expr string) string {
@@ -177,6 +182,16 @@ func convertEntToBiz(f *entgen.Field, nodeName string, expr string) string {
 }
 
 func convertBizToEnt(f *entgen.Field, nodeName string, expr string) string {
+	entExpr := convertBizToEntValue(f, nodeName, expr)
+	if f.Nillable && !f.IsEnum() && explicitBizType(f) != "" {
+		return fmt.Sprintf("func() *%s { x := %s; return &x }()", f.Type.String(), entExpr)
+	}
+	return entExpr
+}
+
+// convertBizToEntValue converts the biz value expr of a field to its ent
+// (non-pointer) type, as taken by the builder setters.
+func convertBizToEntValue(f *entgen.Field, nodeName string, expr string) string {
 	if f.IsEnum() {
 		if isExternalEnum(f) {
 			return expr
@@ -209,12 +224,6 @@ func convertBizToEnt(f *entgen.Field, nodeName string, expr string) string {
 	} else {
 		entExpr = fmt.Sprintf("%s(%s)", entType, expr)
 	}
-
-	isPtr := f.Nillable
-	if isPtr {
-		return fmt.Sprintf("func() *%s { x := %s; return &x }()", entType, entExpr)
-	}
-
 	return entExpr
 }
 
@@ -224,7 +233,7 @@ func edgeConvertToProto(e *entgen.Edge) string {
 	}
 	if isProtoMessage(e) {
 		if isBizPointer(e) {
-			return fmt.Sprintf("Biz%sToProto(b.%s)", e.Type.Name, bizEdgeName(e))
+			return fmt.Sprintf("Biz%sToProto(b.%s)", edgeTypeName(e), bizEdgeName(e))
 		}
 	} else {
 		if isBizIDOnly(e) {
@@ -238,15 +247,8 @@ func edgeConvertToProto(e *entgen.Edge) string {
 			}
 			return "b." + field
 		}
-		access := fmt.Sprintf("b.%s.UUID", bizEdgeName(e))
-		typ := e.Type.ID.Type.String()
-		if typ == "uuid.UUID" {
-			return access
-		}
-		if typ == "int" || typ == "int32" {
-			return fmt.Sprintf("int32(%s)", access)
-		}
-		return access
+		// Set up by edgeStubsToProtoSetup, as the biz entity may be nil
+		return camel(protoStructField(e))
 	}
 	return "nil"
 }
@@ -254,16 +256,12 @@ func edgeConvertToProto(e *entgen.Edge) string {
 func edgeConvertFromProto(e *entgen.Edge) string {
 	if isProtoMessage(e) {
 		if isBizPointer(e) {
-			return fmt.Sprintf("Proto%sToBiz(p.%s)", e.Type.Name, protoStructField(e))
+			return fmt.Sprintf("Proto%sToBiz(p.%s)", edgeTypeName(e), protoStructField(e))
 		}
 	} else {
 		if isBizPointer(e) && isProtoID(e) {
-			if e.Unique {
-				idAccess := fmt.Sprintf("p.%s", protoStructField(e))
-				return fmt.Sprintf("&biz.%s{%sBase: biz.%sBase{UUID: %s}}",
-					e.Type.Name, e.Type.Name, e.Type.Name, idAccess)
-			}
-			return "nil"
+			// Stubs are built by edgeStubsFromProtoSetup
+			return camel(bizEdgeName(e))
 		}
 
 		if isBizIDOnly(e) {
@@ -339,7 +337,7 @@ func convertFromProtoSetup(f *entgen.Field, nodeName string) string {
 	// var err error
 	// <Name>Val, err = uuid.Parse(p.<ProtoName>)
 	varName := camel(f.StructField()) + "Val"
-	return fmt.Sprintf("%s, err := uuid.Parse(p.%s)\nif err != nil {\n\treturn nil, fmt.Errorf(\"invalid UUID for %s: %%w\", err)\n}", varName, protoGoName(f), f.Name)
+	return fmt.Sprintf("%s, err := uuid.Parse(p.%s)\nif err != nil {\n\treturn nil, %s\n}", varName, protoGoName(f), invalidArgumentErr(nodeName, "invalid UUID for "+f.Name))
 }
 
 func convertFromProtoUsage(f *entgen.Field, nodeName string) string {
@@ -363,19 +361,19 @@ func convertBizToEntSetup(f *entgen.Field, nodeName string) string {
 
 	// Special handling for Nillable/Optional UUID (implied string in Biz)
 	if f.Nillable && f.Type.String() == "uuid.UUID" {
-		return fmt.Sprintf("var %s *uuid.UUID\nif %s != \"\" {\n\tparsed, err := uuid.Parse(%s)\n\tif err != nil {\n\t\treturn nil, fmt.Errorf(\"invalid UUID for %s: %%w\", err)\n\t}\n\t%s = &parsed\n}", varName, bizExpr, bizExpr, f.Name, varName)
+		return fmt.Sprintf("var %s *uuid.UUID\nif %s != \"\" {\n\tparsed, err := uuid.Parse(%s)\n\tif err != nil {\n\t\treturn nil, %s\n\t}\n\t%s = &parsed\n}", varName, bizExpr, bizExpr, invalidArgumentErr(nodeName, "invalid UUID for "+f.Name), varName)
 	}
 
 	if f.Type.String() == "uuid.UUID" {
-		return fmt.Sprintf("%s, err := uuid.Parse(%s)\nif err != nil {\n\treturn nil, fmt.Errorf(\"invalid UUID for %s: %%w\", err)\n}", varName, bizExpr, f.Name)
+		return fmt.Sprintf("%s, err := uuid.Parse(%s)\nif err != nil {\n\treturn nil, %s\n}", varName, bizExpr, invalidArgumentErr(nodeName, "invalid UUID for "+f.Name))
 	}
 
 	if f.Type.String() == "time.Time" && explicitBizType(f) == "string" {
 		if f.Nillable {
 			// Optional Time
-			return fmt.Sprintf("var %s *time.Time\nif %s != \"\" {\n\tparsed, err := time.Parse(time.RFC3339, %s)\n\tif err != nil {\n\t\treturn nil, fmt.Errorf(\"invalid Time format for %s: %%w\", err)\n\t}\n\t%s = &parsed\n}", varName, bizExpr, bizExpr, f.Name, varName)
+			return fmt.Sprintf("var %s *time.Time\nif %s != \"\" {\n\tparsed, err := time.Parse(time.RFC3339, %s)\n\tif err != nil {\n\t\treturn nil, %s\n\t}\n\t%s = &parsed\n}", varName, bizExpr, bizExpr, invalidArgumentErr(nodeName, "invalid Time format for "+f.Name), varName)
 		}
-		return fmt.Sprintf("%s, err := time.Parse(time.RFC3339, %s)\nif err != nil {\n\treturn nil, fmt.Errorf(\"invalid Time format for %s: %%w\", err)\n}", varName, bizExpr, f.Name)
+		return fmt.Sprintf("%s, err := time.Parse(time.RFC3339, %s)\nif err != nil {\n\treturn nil, %s\n}", varName, bizExpr, invalidArgumentErr(nodeName, "invalid Time format for "+f.Name))
 	}
 
 	return ""
@@ -398,3 +396,82 @@ func convertBizToEntUsage(f *entgen.Field, nodeName string) string {
 	}
 	return convertBizToEnt(f, nodeName, fmt.Sprintf("b.%s", bizFieldName(f)))
 }
+
+// BizPointerWithProtoID: incoming IDs become biz stubs. UUIDs are validated,
+// int IDs are stringified.
+func edgeStubsFromProtoSetup(e *entgen.Edge) string {
+	varName := camel(bizEdgeName(e))
+	src := fmt.Sprintf("p.%s", protoStructField(e))
+	typ := edgeIDType(e)
+
+	stub := func(id string) string {
+		return fmt.Sprintf("biz.New%sStub(%s)", e.Type.Name, id)
+	}
+	idExpr := func(v string) string {
+		if typ == "int" || typ == "int32" || typ == "int64" {
+			return fmt.Sprintf("strconv.Itoa(int(%s))", v)
+		}
+		return v
+	}
+	check := func(v string) string {
+		if typ != "uuid.UUID" {
+			return ""
+		}
+		return fmt.Sprintf("if _, err := uuid.Parse(%s); err != nil {\n\treturn nil, %s\n}\n", v, invalidArgumentErr(edgeNode(e).Name, "invalid UUID for "+e.Name))
+	}
+
+	if e.Unique {
+		empty := `""`
+		if typ == "int" || typ == "int32" || typ == "int64" {
+			empty = "0"
+		}
+		return fmt.Sprintf("var %s *biz.%s\nif %s != %s {\n%s%s = %s\n}",
+			varName, e.Type.Name, src, empty, check(src), varName, stub(idExpr(src)))
+	}
+	return fmt.Sprintf("var %s []*biz.%s\nfor _, item := range %s {\n%s%s = append(%s, %s)\n}",
+		varName, e.Type.Name, src, check("item"), varName, varName, stub(idExpr("item")))
+}
+
+// BizPointerWithProtoID: a nil entity of a unique edge maps to the zero ID.
+func edgeStubsToProtoSetup(e *entgen.Edge) string {
+	varName := camel(protoStructField(e))
+	typ := edgeIDType(e)
+	if e.Unique {
+		if typ == "int" || typ == "int32" || typ == "int64" {
+			return fmt.Sprintf("var %s %s\nif b.%s != nil {\n\tid, err := strconv.Atoi(b.%s.UUID)\n\tif err != nil {\n\t\treturn nil, fmt.Errorf(\"invalid ID for %s: %%w\", err)\n\t}\n\t%s = %s(id)\n}",
+				varName, edgeProtoType(e), bizEdgeName(e), bizEdgeName(e), e.Name, varName, edgeProtoType(e))
+		}
+		return fmt.Sprintf("var %s %s\nif b.%s != nil {\n\t%s = b.%s.UUID\n}",
+			varName, edgeProtoType(e), bizEdgeName(e), varName, bizEdgeName(e))
+	}
+	if typ == "int" || typ == "int32" || typ == "int64" {
+		return fmt.Sprintf("var %s []%s\nfor _, item := range b.%s {\n\tif item == nil {\n\t\tcontinue\n\t}\n\tid, err := strconv.Atoi(item.UUID)\n\tif err != nil {\n\t\treturn nil, fmt.Errorf(\"invalid ID for %s: %%w\", err)\n\t}\n\t%s = append(%s, %s(id))\n}",
+			varName, edgeProtoType(e), bizEdgeName(e), e.Name, varName, varName, edgeProtoType(e))
+	}
+	return fmt.Sprintf("var %s []%s\nfor _, item := range b.%s {\n\tif item == nil {\n\t\tcontinue\n\t}\n\t%s = append(%s, item.UUID)\n}",
+		varName, edgeProtoType(e), bizEdgeName(e), varName, varName)
+}
+
+func entIDFromBizSetup(id *entgen.Field, entity, expr, varName string) string {
+	return entIDFromBiz(id, entity, expr, varName, "")
+}
+
+// ret prefixes the returned error, e.g. "nil, ". Malformed IDs are invalid
+// arguments of entity.
+func entIDFromBiz(id *entgen.Field, entity, expr, varName, ret string) string {
+	switch typ := id.Type.String(); typ {
+	case "uuid.UUID":
+		return fmt.Sprintf("%s, err := uuid.Parse(%s)\nif err != nil {\n\treturn %s%s\n}", varName, expr, ret, invalidArgumentErr(entity, "invalid UUID for "+id.Name))
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return fmt.Sprintf("n, err := strconv.ParseInt(%s, 10, 64)\nif err != nil {\n\treturn %s%s\n}\n%s := %s(n)", expr, ret, invalidArgumentErr(entity, "invalid ID for "+id.Name), varName, typ)
+	default:
+		return fmt.Sprintf("%s := %s(%s)", varName, typ, expr)
+	}
+}
+
+// invalidArgumentErr returns the expression wrapping the parse error err of
+// the value described by msg as an invalid argument of entity, e.g.
+// invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err)).
+func invalidArgumentErr(entity, msg string) string {
+	return fmt.Sprintf("invalidArgument(%q, fmt.Errorf(%q, err))", entity, msg+": %w")
+}
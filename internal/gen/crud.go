@@ -0,0 +1,221 @@
+package gen
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	entgen "entgo.io/ent/entc/gen"
+	"github.com/Cromemadnd/lazyent/internal/types"
+)
+
+var versionRe = regexp.MustCompile(`^v\d+((alpha|beta)\d*)?$`)
+
+func crudMethods(n *entgen.Type) []types.CRUDMethod {
+	a := getSchemaAnnotation(n)
+	if a == nil || len(a.CRUDMethods) == 0 {
+		return nil
+	}
+	var res []types.CRUDMethod
+	for _, m := range types.AllCRUDMethods {
+		for _, want := range a.CRUDMethods {
+			if m == want {
+				res = append(res, m)
+				break
+			}
+		}
+	}
+	return res
+}
+
+func hasCRUDMethod(n *entgen.Type, m types.CRUDMethod) bool {
+	for _, v := range crudMethods(n) {
+		if v == m {
+			return true
+		}
+	}
+	return false
+}
+
+func hasCRUDService(n *entgen.Type) bool {
+	return n.ID != nil && len(crudMethods(n)) > 0
+}
+
+func hasCRUDNodes(g *entgen.Graph) bool {
+	for _, n := range g.Nodes {
+		if hasCRUDService(n) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkCRUDSingleFile rejects CRUD services in multi-file mode: the request
+// messages of edge list RPCs refer to the messages of other nodes, so per-node
+// proto files would have to import each other.
+func checkCRUDSingleFile(g *entgen.Graph, singleFile bool) error {
+	if singleFile {
+		return nil
+	}
+	for _, n := range g.Nodes {
+		if hasCRUDService(n) {
+			return fmt.Errorf("lazyent: %s: CRUD services require Config.SingleFile", n.Name)
+		}
+	}
+	return nil
+}
+
+func crudServiceFileName(n *entgen.Type) string {
+	return entgen.Funcs["snake"].(func(string) string)(n.Name) + "_service.go"
+}
+
+func crudEntityField(n *entgen.Type) string {
+	return pascal(entgen.Funcs["snake"].(func(string) string)(n.Name))
+}
+
+func crudListField(n *entgen.Type) string {
+	return pascal(crudResource(n))
+}
+
+func crudResource(n *entgen.Type) string {
+	return entgen.Funcs["plural"].(func(string) string)(entgen.Funcs["snake"].(func(string) string)(n.Name))
+}
+
+func crudRPCName(n *entgen.Type, m types.CRUDMethod) string {
+	if m == types.CRUDList {
+		return "List" + pascal(crudResource(n))
+	}
+	return pascal(string(m)) + n.Name
+}
+
+func protoIDName(n *entgen.Type) string {
+	return protoFieldName(n.ID)
+}
+
+// httpPrefix returns the version prefix of HTTP routes taken from the proto
+// package, e.g. /v1 for user.v1.
+func (e *Generator) httpPrefix() string {
+	parts := strings.Split(e.conf.ProtoPackage, ".")
+	if last := parts[len(parts)-1]; versionRe.MatchString(last) {
+		return "/" + last
+	}
+	return ""
+}
+
+// buildCRUDService appends the CRUD service of a node annotated with
+// WithCRUDService and its request/reply messages to the file.
+func (e *Generator) buildCRUDService(n *entgen.Type, f *PbFile) {
+	if !hasCRUDService(n) {
+		return
+	}
+	methods := crudMethods(n)
+	f.AddImport("google/api/annotations.proto")
+
+	var (
+		svc        = &PbService{Name: n.Name + "Service"}
+		msgField   = entgen.Funcs["snake"].(func(string) string)(n.Name)
+		collection = e.httpPrefix() + "/" + crudResource(n)
+		idName     = protoIDName(n)
+		itemPath   = fmt.Sprintf("%s/{%s}", collection, idName)
+		idField    = func() *PbField {
+			pf := &PbField{
+				Name:  idName,
+				Type:  e.resolveProtoType(n.ID, n.Name, f),
+				Tag:   1,
+				Rules: getValidateRules(n.ID, n, e.conf.ProtoValidator),
+			}
+			if r := e.resources[n]; r != nil {
+				r.nameProtoField(pf)
+			}
+			return pf
+		}
+		entityField = func(tag int) *PbField {
+			return &PbField{Name: msgField, Type: n.Name, Tag: tag}
+		}
+	)
+	// Resources are addressed by their name, e.g. /v1/{resource_name=users/*}.
+	if r := e.resources[n]; r != nil {
+		itemPath = r.HTTPPath(e.httpPrefix())
+	}
+	addMessages := func(msgs ...*PbMessage) {
+		for _, m := range msgs {
+			f.Elements = append(f.Elements, PbElement{Message: m})
+		}
+	}
+
+	for _, m := range methods {
+		if m == types.CRUDGetByUnique {
+			for _, k := range protoUniqueKeys(n, e.conf.KeepSensitiveInBiz) {
+				rpc := &PbMethod{
+					Name:     k.RPCName(),
+					Request:  k.RPCName() + "Request",
+					Reply:    k.RPCName() + "Reply",
+					Comment:  fmt.Sprintf("%s 按唯一键 (%s) 获取 %s", k.RPCName(), k.Columns(), n.Name),
+					HTTPVerb: "get",
+					HTTPPath: k.HTTPPath(collection),
+				}
+				req := &PbMessage{Name: rpc.Request}
+				for i, fld := range k.Fields {
+					pf := e.buildProtoField(fld, n, f)
+					pf.Tag = i + 1
+					req.Fields = append(req.Fields, pf)
+				}
+				svc.Methods = append(svc.Methods, rpc)
+				addMessages(req, &PbMessage{Name: rpc.Reply, Fields: []*PbField{entityField(1)}})
+			}
+			continue
+		}
+		rpc := &PbMethod{
+			Name:    crudRPCName(n, m),
+			Request: crudRPCName(n, m) + "Request",
+			Reply:   crudRPCName(n, m) + "Reply",
+		}
+		req := &PbMessage{Name: rpc.Request}
+		reply := &PbMessage{Name: rpc.Reply}
+		switch m {
+		case types.CRUDCreate:
+			rpc.Comment = fmt.Sprintf("%s 创建 %s", rpc.Name, n.Name)
+			rpc.HTTPVerb, rpc.HTTPPath, rpc.HTTPBody = "post", collection, "*"
+			req = e.buildCreateRequest(n, rpc.Request, f)
+			reply.Fields = []*PbField{entityField(1)}
+		case types.CRUDGet:
+			rpc.Comment = fmt.Sprintf("%s 获取 %s", rpc.Name, n.Name)
+			rpc.HTTPVerb, rpc.HTTPPath = "get", itemPath
+			req.Fields = []*PbField{idField()}
+			reply.Fields = []*PbField{entityField(1)}
+		case types.CRUDUpdate:
+			rpc.Comment = fmt.Sprintf("%s 更新 %s", rpc.Name, n.Name)
+			rpc.HTTPVerb, rpc.HTTPPath, rpc.HTTPBody = "put", itemPath, "*"
+			req = e.buildUpdateRequest(n, rpc.Request, f)
+			reply.Fields = []*PbField{entityField(1)}
+		case types.CRUDDelete:
+			rpc.Comment = fmt.Sprintf("%s 删除 %s", rpc.Name, n.Name)
+			rpc.HTTPVerb, rpc.HTTPPath = "delete", itemPath
+			req.Fields = []*PbField{idField()}
+		case types.CRUDList:
+			rpc.Comment = fmt.Sprintf("%s 列出 %s", rpc.Name, n.Name)
+			rpc.HTTPVerb, rpc.HTTPPath = "get", collection
+			e.buildListMessages(n, f)
+			req.Fields = []*PbField{
+				{Name: "page_size", Type: "int32", Tag: 1, Comment: "每页数量，为 0 时使用默认值"},
+				{Name: "page_token", Type: "string", Tag: 2, Comment: "上一页返回的 next_page_token，为空时从第一页开始"},
+				{Name: "filter", Type: n.Name + "Filter", Tag: 3},
+				{Name: "order_by", Type: orderEnumName(n), Tag: 4, Comment: "排序字段，未指定时按 ID 排序"},
+				{Name: "desc", Type: "bool", Tag: 5, Comment: "是否降序"},
+			}
+			if softDeleteField(n) != nil {
+				req.Fields = append(req.Fields, &PbField{Name: "include_deleted", Type: "bool", Tag: 6, Comment: "是否包含已软删除的 " + n.Name})
+			}
+			reply.Fields = []*PbField{
+				{Name: crudResource(n), Type: n.Name, Tag: 1, Repeated: true},
+				{Name: "next_page_token", Type: "string", Tag: 2, Comment: "下一页的 page_token，为空时没有更多数据"},
+			}
+		}
+		svc.Methods = append(svc.Methods, rpc)
+		addMessages(req, reply)
+	}
+	e.buildRestoreRPC(n, svc, f, itemPath, idField)
+	e.buildStreamRPC(n, svc, f)
+	e.buildSubResources(n, svc, f, itemPath, idField)
+	f.Services = append(f.Services, svc)
+}
@@ -0,0 +1,149 @@
+package gen
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	entgen "entgo.io/ent/entc/gen"
+)
+
+// ErrorReason is a value of the ErrorReason enum of errors_gen.proto.
+type ErrorReason struct {
+	Name    string // enum value, e.g. GROUP_NAME_CONFLICT
+	Number  int    // enum number
+	Code    int    // HTTP status, set as the errors.code option
+	Comment string
+	// Markers identify the violated unique constraint in the message of a
+	// driver error, e.g. "groups.name" (SQLite, MySQL) or "groups_name_key"
+	// (PostgreSQL). Only set for unique key conflicts.
+	Markers []string
+}
+
+type NodeErrorReasons struct {
+	Node     *entgen.Type
+	NotFound ErrorReason
+	Conflict ErrorReason
+	Invalid  ErrorReason
+	Unique   []ErrorReason // one per unique constraint
+	// ConcurrentModification is set for nodes with a version field.
+	ConcurrentModification *ErrorReason
+}
+
+func (r NodeErrorReasons) Reasons() []ErrorReason {
+	res := append([]ErrorReason{r.NotFound, r.Conflict, r.Invalid}, r.Unique...)
+	if r.ConcurrentModification != nil {
+		res = append(res, *r.ConcurrentModification)
+	}
+	return res
+}
+
+// errorReasons returns the error reasons of the nodes with an ID, numbered
+// in schema order from 1.
+func errorReasons(g *entgen.Graph) []NodeErrorReasons {
+	var (
+		res    []NodeErrorReasons
+		number int
+	)
+	next := func(r ErrorReason) ErrorReason {
+		number++
+		r.Number = number
+		return r
+	}
+	for _, n := range g.Nodes {
+		if n.ID == nil {
+			continue
+		}
+		prefix := strings.ToUpper(entgen.Funcs["snake"].(func(string) string)(n.Name))
+		nr := NodeErrorReasons{
+			Node: n,
+			NotFound: next(ErrorReason{
+				Name: prefix + "_NOT_FOUND", Code: http.StatusNotFound,
+				Comment: n.Name + " 不存在",
+			}),
+			Conflict: next(ErrorReason{
+				Name: prefix + "_CONFLICT", Code: http.StatusConflict,
+				Comment: "写入 " + n.Name + " 时违反了完整性约束",
+			}),
+			Invalid: next(ErrorReason{
+				Name: prefix + "_INVALID_ARGUMENT", Code: http.StatusBadRequest,
+				Comment: n.Name + " 的字段未通过校验",
+			}),
+		}
+		for _, c := range uniqueConstraints(n) {
+			nr.Unique = append(nr.Unique, next(ErrorReason{
+				Name:    prefix + "_" + strings.ToUpper(strings.Join(c.names, "_AND_")) + "_CONFLICT",
+				Code:    http.StatusConflict,
+				Comment: fmt.Sprintf("%s 的唯一键 (%s) 已存在", n.Name, strings.Join(c.names, ", ")),
+				Markers: c.markers,
+			}))
+		}
+		if versionField(n) != nil {
+			r := next(ErrorReason{
+				Name: prefix + "_CONCURRENT_MODIFICATION", Code: http.StatusConflict,
+				Comment: n.Name + " 已被并发修改，版本不匹配",
+			})
+			nr.ConcurrentModification = &r
+		}
+		res = append(res, nr)
+	}
+	return res
+}
+
+// uniqueConstraint is a unique field or a unique index of a node.
+type uniqueConstraint struct {
+	names   []string // field names, or column names of edge columns
+	markers []string
+}
+
+// uniqueConstraints returns the unique constraints of a node: its Unique
+// fields first, then its unique indexes, in schema order.
+func uniqueConstraints(n *entgen.Type) []uniqueConstraint {
+	table := n.Table()
+	var res []uniqueConstraint
+	for _, f := range n.Fields {
+		if !f.Unique {
+			continue
+		}
+		col := f.StorageKey()
+		res = append(res, uniqueConstraint{
+			names:   []string{f.Name},
+			markers: []string{table + "." + col, table + "_" + col + "_key"},
+		})
+	}
+	byColumn := make(map[string]*entgen.Field, len(n.Fields))
+	for _, f := range n.Fields {
+		byColumn[f.StorageKey()] = f
+	}
+	for _, idx := range n.Indexes {
+		if !idx.Unique {
+			continue
+		}
+		if len(idx.Columns) == 1 {
+			if f, ok := byColumn[idx.Columns[0]]; ok && f.Unique {
+				continue
+			}
+		}
+		var (
+			c         uniqueConstraint
+			qualified = make([]string, 0, len(idx.Columns))
+		)
+		for _, col := range idx.Columns {
+			name := col
+			if f, ok := byColumn[col]; ok {
+				name = f.Name
+			}
+			c.names = append(c.names, name)
+			qualified = append(qualified, table+"."+col)
+		}
+		c.markers = []string{strings.Join(qualified, ", ")}
+		// One column indexes are named after their column, too generic to match.
+		if len(idx.Columns) == 1 {
+			c.markers = append(c.markers, table+"_"+idx.Columns[0]+"_key")
+		} else {
+			c.markers = append(c.markers, idx.Name)
+		}
+		res = append(res, c)
+	}
+	return res
+}
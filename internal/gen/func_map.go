@@ -12,7 +12,7 @@ var funcMap = template.FuncMap{
 	"getEnumValues":        getEnumValues,
 	"getEnumPairs":         getEnumPairs,
 	"protoType":            protoType,
-	"getValidateRules":     func(f *entgen.Field) string { return getValidateRules(f, "", types.ProtoValidatorPGV) }, // Adapter for template if used
+	"getValidateRules":     func(f *entgen.Field) string { return getValidateRules(f, nil, types.ProtoValidatorPGV) }, // Adapter for template if used
 	"getProtoTag":          getProtoTag,
 	"convertToProto":       convertToProto,
 	"convertFromProto":     convertFromProto,
@@ -70,4 +70,124 @@ var funcMap = template.FuncMap{
 	"convertFromProtoUsage": convertFromProtoUsage,
 	"convertBizToEntSetup":  convertBizToEntSetup,
 	"convertBizToEntUsage":  convertBizToEntUsage,
+
+	"edgeStubsFromProtoSetup": edgeStubsFromProtoSetup,
+	"edgeStubsToProtoSetup":   edgeStubsToProtoSetup,
+
+	"edgeTypeName":       edgeTypeName,
+	"isThroughEdge":      isThroughEdge,
+	"hasEdgeSchemaEdges": hasEdgeSchemaEdges,
+
+	"hasEdgeCount":       hasEdgeCount,
+	"edgeCountName":      edgeCountName,
+	"edgeCountProtoName": edgeCountProtoName,
+	"edgeCountAggregate": edgeCountAggregate,
+	"entIDFromBizSetup":  entIDFromBizSetup,
+
+	"isFieldProtoReadOnly": isFieldProtoReadOnly,
+
+	"hasCRUDMethod":        hasCRUDMethod,
+	"hasCRUDService":       hasCRUDService,
+	"crudRPCName":          crudRPCName,
+	"crudEntityField":      crudEntityField,
+	"crudListField":        crudListField,
+	"createInputID":        createInputID,
+	"inputEdges":           inputEdges,
+	"patchFieldType":       patchFieldType,
+	"patchEdgeName":        patchEdgeName,
+	"patchEdgeType":        patchEdgeType,
+	"idFromProto":          idFromProto,
+	"createFieldFromProto": createFieldFromProto,
+	"createEdgeFromProto":  createEdgeFromProto,
+	"patchFieldFromProto":  patchFieldFromProto,
+	"patchEdgeFromProto":   patchEdgeFromProto,
+
+	"protoFieldName":        protoFieldName,
+	"protoEdgeName":         protoEdgeName,
+	"protoPresenceCond":     protoPresenceCond,
+	"protoEdgePresenceCond": protoEdgePresenceCond,
+	"applyFieldFromBiz":     applyFieldFromBiz,
+	"applyEdgeFromBiz":      applyEdgeFromBiz,
+
+	"builderEdges":       builderEdges,
+	"entIDFromBiz":       entIDFromBiz,
+	"createFieldFromBiz": createFieldFromBiz,
+	"createEdgeFromBiz":  createEdgeFromBiz,
+	"updateFieldFromBiz": updateFieldFromBiz,
+	"updateEdgeFromBiz":  updateEdgeFromBiz,
+
+	"hasRepo":                 hasRepo,
+	"hasRepoMethod":           hasRepoMethod,
+	"bizValueType":            bizValueType,
+	"repoEdges":               repoEdges,
+	"repoEdgeListName":        repoEdgeListName,
+	"eagerLoad":               eagerLoad,
+	"edgeSoftDeletePredicate": edgeSoftDeletePredicate,
+	"edgeTenantPredicate":     edgeTenantPredicate,
+	"edgeScopeTenant":         edgeScopeTenant,
+	"edgeScopeSetup":          edgeScopeSetup,
+	"plural":                  entgen.Funcs["plural"],
+
+	"filterBizName":   filterBizName,
+	"filterBizType":   filterBizType,
+	"filterFromProto": filterFromProto,
+	"filterToEnt":     filterToEnt,
+	"orderEnumName":   orderEnumName,
+	"orderEnumValue":  orderEnumValue,
+
+	"subResources": subResources,
+
+	"versionField":    versionField,
+	"versionOptional": isVersionOptional,
+	"softDeleteField": softDeleteField,
+	"hasRestoreRepo":  hasRestoreRepo,
+	"hasRestoreRPC":   hasRestoreRPC,
+	"restoreRPCName":  restoreRPCName,
+	"tenantField":     tenantField,
+	"tenantSetup":     tenantSetup,
+
+	"hasStreamRPC":  hasStreamRPC,
+	"hasStreamRepo": hasStreamRepo,
+	"streamRPCName": streamRPCName,
+}
+
+// funcs returns the template functions, including those depending on the
+// generator configuration.
+func (e *Generator) funcs() template.FuncMap {
+	keep := e.conf.KeepSensitiveInBiz
+	m := make(template.FuncMap, len(funcMap)+20)
+	for k, v := range funcMap {
+		m[k] = v
+	}
+	m["isFieldBizExclude"] = func(f *entgen.Field) bool { return isFieldBizExclude(f, keep) }
+	m["isFieldProtoExclude"] = func(f *entgen.Field) bool { return isFieldProtoExclude(f, keep) }
+	m["redactedFields"] = func(fields []*entgen.Field) []*entgen.Field { return redactedFields(fields, keep) }
+	m["getNodeAssociations"] = func(edges []*entgen.Edge) []AssocDef { return getNodeAssociations(edges, keep) }
+	m["getAllAssociations"] = func(nodes []interface{}) []AssocDef { return getAllAssociations(nodes, keep) }
+	m["createInputFields"] = func(n *entgen.Type) []*entgen.Field { return createInputFields(n, keep) }
+	m["updateInputFields"] = func(n *entgen.Type) []*entgen.Field { return updateInputFields(n, keep) }
+	m["builderCreateFields"] = func(n *entgen.Type) []*entgen.Field { return builderCreateFields(n, keep) }
+	m["builderUpdateFields"] = func(n *entgen.Type) []*entgen.Field { return builderUpdateFields(n, keep) }
+	m["updateMaskPaths"] = func(n *entgen.Type) []MaskPath { return updateMaskPaths(n, keep) }
+	m["immutableMaskPaths"] = func(n *entgen.Type) []string { return immutableMaskPaths(n, keep) }
+	m["ownedMaskPaths"] = ownedMaskPaths
+	m["uniqueKeys"] = func(n *entgen.Type) []UniqueKey { return uniqueKeys(n, keep) }
+	m["protoUniqueKeys"] = func(n *entgen.Type) []UniqueKey { return protoUniqueKeys(n, keep) }
+	m["listFilters"] = func(n *entgen.Type) []ListFilter { return listFilters(n, keep) }
+	m["listOrderFields"] = func(n *entgen.Type) []*entgen.Field { return listOrderFields(n, keep) }
+	m["hasList"] = e.hasList
+	m["hasProtoList"] = e.hasProtoList
+	m["hasListNodes"] = e.hasListNodes
+	m["hasErrorReasons"] = func() bool { return e.conf.ErrorReasons }
+	m["errorReasons"] = func() []NodeErrorReasons { return errorReasons(e.graph) }
+	m["resource"] = func(n *entgen.Type) *Resource { return e.resources[n] }
+	m["hasVersionFields"] = func() bool { return hasVersionFields(e.graph) }
+	m["hasAddSubResources"] = func() bool { return hasAddSubResources(e.graph) }
+	m["tenantEntType"] = func() string { return tenantEntType(e.graph) }
+	m["tenantBizType"] = func() string { return tenantBizType(e.graph) }
+	m["visibility"] = func(n *entgen.Type) *Visibility { return e.visibility[n] }
+	m["hasVisibility"] = func() bool { return len(e.visibility) > 0 }
+	m["bizToProto"] = e.bizToProto
+	m["serviceRedacts"] = e.serviceRedacts
+	return m
 }
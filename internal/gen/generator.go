@@ -29,6 +29,7 @@ type PbFile struct {
 	GoPackage string
 	Imports   []string
 	Elements  []PbElement // Unified list
+	Services  []*PbService
 }
 
 type PbElement struct {
@@ -37,9 +38,10 @@ type PbElement struct {
 }
 
 type PbMessage struct {
-	Name    string
-	Fields  []*PbField
-	Comment string
+	Name     string
+	Fields   []*PbField
+	Comment  string
+	Resource *Resource // set for messages of resources, rendered as google.api.resource
 }
 
 type PbField struct {
@@ -48,9 +50,27 @@ type PbField struct {
 	Tag      int
 	Rules    string // PGV Validation rules
 	Repeated bool
+	Optional bool // proto3 optional (explicit presence)
 	Comment  string
 }
 
+type PbService struct {
+	Name    string
+	Methods []*PbMethod
+}
+
+type PbMethod struct {
+	Name     string
+	Request  string
+	Reply    string
+	Comment  string
+	HTTPVerb string // get, post, put, patch, delete
+	HTTPPath string
+	HTTPBody string
+	// ServerStream marks server-streaming RPCs, which have no HTTP binding.
+	ServerStream bool
+}
+
 type PbEnum struct {
 	Name   string
 	Values []*PbEnumValue
@@ -76,13 +96,17 @@ func (f *PbFile) AddImport(path string) {
 
 func Generate(conf Config, g *entgen.Graph) error {
 	e := &Generator{
-		conf: conf,
+		conf:  conf,
+		graph: g,
 	}
 	return e.generate(g)
 }
 
 type Generator struct {
-	conf Config
+	conf       Config
+	graph      *entgen.Graph
+	resources  map[*entgen.Type]*Resource
+	visibility map[*entgen.Type]*Visibility
 }
 
 func (e *Generator) generate(g *entgen.Graph) error {
@@ -93,6 +117,25 @@ func (e *Generator) generate(g *entgen.Graph) error {
 
 	// 1. Resolve Defaults
 	e.resolveDefaults(g)
+	if err := checkSchemaAnnotations(g); err != nil {
+		return err
+	}
+	if e.resources, err = buildResources(g, e.conf.KeepSensitiveInBiz); err != nil {
+		return err
+	}
+	if err := checkVersionFields(g, e.conf.KeepSensitiveInBiz); err != nil {
+		return err
+	}
+	if err := checkSoftDeleteFields(g); err != nil {
+		return err
+	}
+	if err := checkTenantFields(g, e.conf.KeepSensitiveInBiz); err != nil {
+		return err
+	}
+	if err := checkCRUDSingleFile(g, e.conf.SingleFile); err != nil {
+		return err
+	}
+	e.visibility = buildVisibility(g, e.conf.KeepSensitiveInBiz)
 
 	// 2. Prepare Template Data
 	protoPkg := e.conf.ProtoPackage
@@ -119,6 +162,7 @@ func (e *Generator) generate(g *entgen.Graph) error {
 			}
 		}
 		nodeData := map[string]interface{}{
+			"Type":   n,
 			"Name":   n.Name,
 			"ID":     n.ID,
 			"Fields": n.Fields,
@@ -127,10 +171,19 @@ func (e *Generator) generate(g *entgen.Graph) error {
 		}
 		allNodes = append(allNodes, nodeData)
 	}
+	commonData["AllNodes"] = allNodes
 
 	// 4. Generate
 	var generatedProtoFiles []string
 
+	// Package-wide template data: all nodes
+	data := make(map[string]interface{})
+	for k, v := range commonData {
+		data[k] = v
+	}
+	data["Nodes"] = allNodes
+	data["Shared"] = true
+
 	if e.conf.SingleFile {
 		// --- Phase 1: Proto Generation ---
 		// Proto Files (Using new Builder)
@@ -150,13 +203,6 @@ func (e *Generator) generate(g *entgen.Graph) error {
 		generatedProtoFiles = append(generatedProtoFiles, protoPath)
 
 		// --- Phase 3: Go Generation ---
-		// Single file generation data
-		data := make(map[string]interface{})
-		for k, v := range commonData {
-			data[k] = v
-		}
-		data["Nodes"] = allNodes
-
 		// Biz Base
 		if err := e.render(nil, "templates/base.tmpl", filepath.Join(moduleRoot, e.conf.BizOut, e.conf.BizBaseFileName), data); err != nil {
 			return err
@@ -179,7 +225,6 @@ func (e *Generator) generate(g *entgen.Graph) error {
 		if err := e.render(nil, "templates/data_mapper.tmpl", filepath.Join(moduleRoot, e.conf.DataOut, e.conf.DataMapperFileName), data); err != nil {
 			return err
 		}
-
 	} else {
 		// Multiple files generation
 
@@ -201,37 +246,155 @@ func (e *Generator) generate(g *entgen.Graph) error {
 		}
 
 		// --- Phase 3: Go Generation ---
-		for _, nd := range allNodes {
-			// Basic template data (legacy templates)
-			data := make(map[string]interface{})
+		for i, nd := range allNodes {
+			// Per-node template data, package-wide declarations go with the first node
+			nodeData := make(map[string]interface{})
 			for k, v := range commonData {
-				data[k] = v
+				nodeData[k] = v
 			}
-			data["Nodes"] = []interface{}{nd}
+			nodeData["Nodes"] = []interface{}{nd}
+			nodeData["Shared"] = i == 0
 			ndMap := nd.(map[string]interface{})
 			name := ndMap["Name"].(string)
 			lName := strings.ToLower(name)
 
 			// 1. Biz Base
-			if err := e.render(nil, "templates/base.tmpl", filepath.Join(moduleRoot, e.conf.BizOut, lName+"_base_gen.go"), data); err != nil {
+			if err := e.render(nil, "templates/base.tmpl", filepath.Join(moduleRoot, e.conf.BizOut, lName+"_base_gen.go"), nodeData); err != nil {
 				return err
 			}
 
 			// 2. Biz Scaffold
 			scaffoldPath := filepath.Join(moduleRoot, e.conf.BizOut, lName+".go")
 			if _, err := os.Stat(scaffoldPath); os.IsNotExist(err) {
-				if err := e.render(nil, "templates/scaffold.tmpl", scaffoldPath, data); err != nil {
+				if err := e.render(nil, "templates/scaffold.tmpl", scaffoldPath, nodeData); err != nil {
 					return err
 				}
 			}
 
 			// 3. Service Mapper
-			if err := e.render(nil, "templates/mapper.tmpl", filepath.Join(moduleRoot, e.conf.ServiceOut, lName+"_mapper.go"), data); err != nil {
+			if err := e.render(nil, "templates/service_mapper.tmpl", filepath.Join(moduleRoot, e.conf.ServiceOut, lName+"_mapper_gen.go"), nodeData); err != nil {
 				return err
 			}
 
 			// 4. Data Mapper
-			if err := e.render(nil, "templates/data.tmpl", filepath.Join(moduleRoot, e.conf.DataOut, lName+"_ent.go"), data); err != nil {
+			if err := e.render(nil, "templates/data_mapper.tmpl", filepath.Join(moduleRoot, e.conf.DataOut, lName+"_ent_gen.go"), nodeData); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Package-wide outputs, the same in both modes
+
+	// Error Reasons
+	if e.conf.ErrorReasons {
+		errorsPath := filepath.Join(moduleRoot, e.conf.ProtoOut, e.conf.ErrorProtoFileName)
+		errorsData := map[string]interface{}{
+			"Package":   e.conf.ProtoPackage,
+			"GoPackage": e.conf.GoPackage,
+			"Nodes":     errorReasons(g),
+		}
+		if err := e.render(nil, "templates/errors_proto.tmpl", errorsPath, errorsData); err != nil {
+			return err
+		}
+		generatedProtoFiles = append(generatedProtoFiles, errorsPath)
+	}
+
+	// Biz Repositories
+	if hasRepoNodes(g) {
+		if err := e.render(nil, "templates/repo.tmpl", filepath.Join(moduleRoot, e.conf.BizOut, e.conf.BizRepoFileName), data); err != nil {
+			return err
+		}
+		repoScaffoldPath := filepath.Join(moduleRoot, e.conf.BizOut, e.conf.BizRepoScaffoldFileName)
+		if _, err := os.Stat(repoScaffoldPath); os.IsNotExist(err) {
+			if err := e.render(nil, "templates/repo_scaffold.tmpl", repoScaffoldPath, data); err != nil {
+				return err
+			}
+		}
+
+		// Biz Usecases
+		if err := e.render(nil, "templates/usecase.tmpl", filepath.Join(moduleRoot, e.conf.BizOut, e.conf.BizUsecaseFileName), data); err != nil {
+			return err
+		}
+		// Usecase implementations (Scaffold, one file per repository)
+		for _, nd := range allNodes {
+			n := nd.(map[string]interface{})["Type"].(*entgen.Type)
+			if !hasRepo(n) {
+				continue
+			}
+			ucPath := filepath.Join(moduleRoot, e.conf.BizOut, usecaseFileName(n))
+			if _, err := os.Stat(ucPath); !os.IsNotExist(err) {
+				continue
+			}
+			ucData := make(map[string]interface{})
+			for k, v := range commonData {
+				ucData[k] = v
+			}
+			ucData["Nodes"] = []interface{}{nd}
+			if err := e.render(nil, "templates/usecase_scaffold.tmpl", ucPath, ucData); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Services
+	if hasCRUDNodes(g) {
+		if err := e.render(nil, "templates/service.tmpl", filepath.Join(moduleRoot, e.conf.ServiceOut, e.conf.SvcServiceFileName), data); err != nil {
+			return err
+		}
+		// Service implementations (Scaffold, one file per service)
+		for _, nd := range allNodes {
+			n := nd.(map[string]interface{})["Type"].(*entgen.Type)
+			if !hasCRUDService(n) {
+				continue
+			}
+			svcPath := filepath.Join(moduleRoot, e.conf.ServiceOut, crudServiceFileName(n))
+			if _, err := os.Stat(svcPath); !os.IsNotExist(err) {
+				continue
+			}
+			svcData := make(map[string]interface{})
+			for k, v := range commonData {
+				svcData[k] = v
+			}
+			svcData["Nodes"] = []interface{}{nd}
+			if err := e.render(nil, "templates/service_scaffold.tmpl", svcPath, svcData); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Data Repositories (Ent)
+	if hasRepoNodes(g) {
+		if err := e.render(nil, "templates/data_repo.tmpl", filepath.Join(moduleRoot, e.conf.DataOut, e.conf.DataRepoFileName), data); err != nil {
+			return err
+		}
+		repoScaffoldPath := filepath.Join(moduleRoot, e.conf.DataOut, e.conf.DataRepoScaffoldFileName)
+		if _, err := os.Stat(repoScaffoldPath); os.IsNotExist(err) {
+			if err := e.render(nil, "templates/data_repo_scaffold.tmpl", repoScaffoldPath, data); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Wire Provider Sets
+	if e.conf.WireProviders {
+		for _, l := range []struct{ layer, out string }{
+			{wireLayerBiz, e.conf.BizOut},
+			{wireLayerData, e.conf.DataOut},
+			{wireLayerService, e.conf.ServiceOut},
+		} {
+			providers := wireProviders(g, l.layer)
+			if len(providers) == 0 {
+				continue
+			}
+			wireData := map[string]interface{}{
+				"Layer":      l.layer,
+				"Providers":  providers,
+				"BizPackage": commonData["BizPackage"],
+			}
+			if l.layer == wireLayerService {
+				wireData["Usecases"] = wireUsecases(g)
+			}
+			if err := e.render(nil, "templates/wire.tmpl", filepath.Join(moduleRoot, l.out, e.conf.WireFileName), wireData); err != nil {
 				return err
 			}
 		}
@@ -267,6 +430,24 @@ func (e *Generator) resolveDefaults(g *entgen.Graph) {
 	if e.conf.BizEntityFileName == "" {
 		e.conf.BizEntityFileName = "entities.go"
 	}
+	if e.conf.BizRepoFileName == "" {
+		e.conf.BizRepoFileName = "repo_gen.go"
+	}
+	if e.conf.BizRepoScaffoldFileName == "" {
+		e.conf.BizRepoScaffoldFileName = "repo.go"
+	}
+	if e.conf.BizUsecaseFileName == "" {
+		e.conf.BizUsecaseFileName = "usecases_gen.go"
+	}
+	if e.conf.SvcServiceFileName == "" {
+		e.conf.SvcServiceFileName = "services_gen.go"
+	}
+	if e.conf.DataRepoFileName == "" {
+		e.conf.DataRepoFileName = "repo_gen.go"
+	}
+	if e.conf.DataRepoScaffoldFileName == "" {
+		e.conf.DataRepoScaffoldFileName = "repo.go"
+	}
 	if e.conf.SvcMapperFileName == "" {
 		e.conf.SvcMapperFileName = "service_mappers_gen.go"
 	}
@@ -276,10 +457,16 @@ func (e *Generator) resolveDefaults(g *entgen.Graph) {
 	if e.conf.ProtoFileName == "" {
 		e.conf.ProtoFileName = "dtos_gen.proto"
 	}
+	if e.conf.ErrorProtoFileName == "" {
+		e.conf.ErrorProtoFileName = "errors_gen.proto"
+	}
+	if e.conf.WireFileName == "" {
+		e.conf.WireFileName = "wire_gen_providers.go"
+	}
 }
 
 func (e *Generator) render(n *entgen.Type, tmplName string, targetPath string, data interface{}) error {
-	t, err := template.New(filepath.Base(tmplName)).Funcs(funcMap).ParseFS(templates, tmplName)
+	t, err := template.New(filepath.Base(tmplName)).Funcs(e.funcs()).ParseFS(templates, tmplName)
 	if err != nil {
 		return fmt.Errorf("failed to parse template %s: %w", tmplName, err)
 	}
@@ -374,6 +561,16 @@ func (e *Generator) buildProtoFile(g *entgen.Graph) (*PbFile, error) {
 
 		// Append Message
 		files.Elements = append(files.Elements, PbElement{Message: msg})
+
+		// Association messages of edge schema edges
+		for _, a := range getNodeAssociations(n.Edges, e.conf.KeepSensitiveInBiz) {
+			files.Elements = append(files.Elements, PbElement{Message: e.buildAssociationMessage(a, files)})
+		}
+	}
+
+	// CRUD services and their request/reply messages
+	for _, n := range g.Nodes {
+		e.buildCRUDService(n, files)
 	}
 	return files, nil
 }
@@ -399,20 +596,58 @@ func (e *Generator) buildSingleNodeProto(g *entgen.Graph, nodeName string) (*PbF
 		}
 		// Enums then Message
 		for _, f := range n.Fields {
-			if f.IsEnum() {
+			if f.IsEnum() && !isExternalEnum(f) {
 				files.Elements = append(files.Elements, PbElement{Enum: e.buildProtoEnum(n, f)})
 			}
 		}
 		msg := e.buildProtoMessage(n, files)
 		files.Elements = append(files.Elements, PbElement{Message: msg})
+		for _, a := range getNodeAssociations(n.Edges, e.conf.KeepSensitiveInBiz) {
+			files.Elements = append(files.Elements, PbElement{Message: e.buildAssociationMessage(a, files)})
+		}
+		e.buildCRUDService(n, files)
 	}
+	e.addNodeProtoImports(g, nodeName, files)
 	return files, nil
 }
 
+// addNodeProtoImports imports the per-node files declaring the messages and
+// enums of other nodes that the file of nodeName refers to.
+func (e *Generator) addNodeProtoImports(g *entgen.Graph, nodeName string, files *PbFile) {
+	owners := make(map[string]string)
+	for _, n := range g.Nodes {
+		file := strings.ToLower(n.Name) + ".proto"
+		owners[n.Name] = file
+		for _, f := range n.Fields {
+			if f.IsEnum() && !isExternalEnum(f) {
+				owners[e.buildProtoEnum(n, f).Name] = file
+			}
+		}
+		for _, a := range getNodeAssociations(n.Edges, e.conf.KeepSensitiveInBiz) {
+			owners[a.Name] = file
+		}
+	}
+	own := owners[nodeName]
+	for _, el := range files.Elements {
+		if el.Message == nil {
+			continue
+		}
+		for _, pf := range el.Message.Fields {
+			if file, ok := owners[pf.Type]; ok && file != own {
+				files.AddImport(file)
+			}
+		}
+	}
+}
+
 func (e *Generator) buildProtoMessage(n *entgen.Type, f *PbFile) *PbMessage {
 	msg := &PbMessage{
 		Name: n.Name,
 	}
+	if r := e.resources[n]; r != nil {
+		msg.Resource = r
+		f.AddImport("google/api/resource.proto")
+	}
 
 	// 1. Fields (ID + Regular)
 	usedTags := make(map[int]bool)
@@ -425,7 +660,10 @@ func (e *Generator) buildProtoMessage(n *entgen.Type, f *PbFile) *PbMessage {
 	edges := e.buildProtoEdges(n)
 	allFields = append(allFields, edges...)
 
-	// 3. Assign Tags
+	// 3. Edge Counts
+	allFields = append(allFields, e.buildProtoEdgeCounts(n)...)
+
+	// 4. Assign Tags
 	e.assignProtoTags(msg, allFields, usedTags)
 
 	return msg
@@ -445,13 +683,17 @@ func (e *Generator) buildProtoFields(n *entgen.Type, f *PbFile, usedTags map[int
 	if n.ID != nil {
 		pf := &PbField{
 			Name:    n.ID.Name,
-			Rules:   getValidateRules(n.ID, n.Name, e.conf.ProtoValidator),
+			Rules:   getValidateRules(n.ID, n, e.conf.ProtoValidator),
 			Comment: n.ID.Comment(),
 		}
 		if a := getFieldAnnotation(n.ID); a != nil && a.ProtoName != "" {
 			pf.Name = a.ProtoName
 		}
 		pf.Type = e.resolveProtoType(n.ID, n.Name, f)
+		// Resources expose their name in place of the ID, keeping its tag.
+		if r := e.resources[n]; r != nil {
+			r.nameProtoField(pf)
+		}
 
 		t := getProtoTag(n.ID, -1)
 		if t > 0 {
@@ -463,39 +705,48 @@ func (e *Generator) buildProtoFields(n *entgen.Type, f *PbFile, usedTags map[int
 
 	// 2. Fields
 	for _, fld := range n.Fields {
-		// Skip sensitive fields
-		if fld.Sensitive() {
+		// Skip sensitive and excluded fields
+		if isFieldProtoExclude(fld, e.conf.KeepSensitiveInBiz) {
 			continue
 		}
 
-		pf := &PbField{
-			Name:    fld.Name,
-			Rules:   getValidateRules(fld, n.Name, e.conf.ProtoValidator),
-			Comment: fld.Comment(),
+		pf := e.buildProtoField(fld, n, f)
+		// Tenant fields are output only, the tenant comes from the context.
+		if isTenantField(fld) && pf.Comment == "" {
+			pf.Comment = "所属租户，只读"
 		}
-		if a := getFieldAnnotation(fld); a != nil && a.ProtoName != "" {
-			pf.Name = a.ProtoName
-		}
-		// For external enums, use string type in proto
-		if fld.IsEnum() && isExternalEnum(fld) {
-			pf.Type = "string"
-		} else {
-			pf.Type = e.resolveProtoType(fld, n.Name, f)
-		}
-		if strings.HasPrefix(fld.Type.String(), "[]") && fld.Type.String() != "[]byte" {
-			pf.Repeated = true
-		}
-
-		t := getProtoTag(fld, -1)
-		if t > 0 {
-			pf.Tag = t
-			usedTags[t] = true
+		if pf.Tag > 0 {
+			usedTags[pf.Tag] = true
 		}
 		results = append(results, fieldInfo{field: fld, pf: pf})
 	}
 	return results
 }
 
+// buildProtoField builds the proto field of a regular field, keeping its
+// manually assigned tag (0 if auto-assigned).
+func (e *Generator) buildProtoField(fld *entgen.Field, n *entgen.Type, f *PbFile) *PbField {
+	nodeName := n.Name
+	pf := &PbField{
+		Name:    protoFieldName(fld),
+		Rules:   getValidateRules(fld, n, e.conf.ProtoValidator),
+		Comment: fld.Comment(),
+	}
+	// For external enums, use string type in proto
+	if fld.IsEnum() && isExternalEnum(fld) {
+		pf.Type = "string"
+	} else {
+		pf.Type = e.resolveProtoType(fld, nodeName, f)
+	}
+	if strings.HasPrefix(fld.Type.String(), "[]") && fld.Type.String() != "[]byte" {
+		pf.Repeated = true
+	}
+	if t := getProtoTag(fld, -1); t > 0 {
+		pf.Tag = t
+	}
+	return pf
+}
+
 func (e *Generator) buildProtoEdges(n *entgen.Type) []fieldInfo {
 	var results []fieldInfo
 	for _, edge := range n.Edges {
@@ -506,41 +757,109 @@ func (e *Generator) buildProtoEdges(n *entgen.Type) []fieldInfo {
 		if isProtoMessage(edge) {
 			pf := &PbField{
 				Name:     edge.Name,
-				Type:     edge.Type.Name,
+				Type:     edgeTypeName(edge),
 				Repeated: !edge.Unique,
 			}
 			results = append(results, fieldInfo{edge: edge, pf: pf})
 		} else {
 			if isProtoID(edge) || (edgeHasFK(edge) && !hasField(n.Fields, edgeField(edge))) {
-				name := edge.Name
-				if a := getAnnotation(edge); a != nil && a.ProtoName != "" {
-					name = a.ProtoName
-				}
+				results = append(results, fieldInfo{edge: edge, pf: e.buildProtoEdgeIDField(edge)})
+			}
+		}
+	}
+	return results
+}
 
-				pf := &PbField{
-					Name:     name,
-					Type:     edgeProtoType(edge),
-					Repeated: !edge.Unique,
-				}
+func (e *Generator) buildProtoEdgeIDField(edge *entgen.Edge) *PbField {
+	pf := &PbField{
+		Name:     protoEdgeName(edge),
+		Type:     edgeProtoType(edge),
+		Repeated: !edge.Unique,
+	}
 
-				// Validation rules
-				if edge.Type.ID.Type.String() == "uuid.UUID" {
-					if pf.Repeated {
-						pf.Rules = ".repeated = {\n    items: {\n      string: { uuid: true }\n    }\n  }"
-					} else {
-						if pf.Type == "string" {
-							pf.Rules = ".string.uuid = true"
-						}
-					}
-				}
+	// Validation rules
+	if pf.Repeated {
+		pf.Rules = repeatedIDRules(edge.Type.ID)
+	} else if edge.Type.ID.Type.String() == "uuid.UUID" && pf.Type == "string" {
+		pf.Rules = ".string.uuid = true"
+	}
+	return pf
+}
 
-				results = append(results, fieldInfo{edge: edge, pf: pf})
-			}
+func repeatedIDRules(id *entgen.Field) string {
+	if id.Type.String() == "uuid.UUID" {
+		return ".repeated = {\n    items: {\n      string: { uuid: true }\n    }\n  }"
+	}
+	return ""
+}
+
+func (e *Generator) buildProtoEdgeCounts(n *entgen.Type) []fieldInfo {
+	var results []fieldInfo
+	for _, edge := range n.Edges {
+		if !hasEdgeCount(edge) {
+			continue
 		}
+		pf := &PbField{
+			Name:    edgeCountProtoName(edge),
+			Type:    "int64",
+			Comment: fmt.Sprintf("%s 的数量", edge.Name),
+		}
+		results = append(results, fieldInfo{edge: edge, pf: pf})
 	}
 	return results
 }
 
+// buildAssociationMessage builds the message of an edge schema association,
+// holding the other side of the M2M edge and the payload fields of the edge schema.
+func (e *Generator) buildAssociationMessage(a AssocDef, f *PbFile) *PbMessage {
+	msg := &PbMessage{
+		Name:    a.Name,
+		Comment: fmt.Sprintf("%s 经由 %s 的关联", a.NodeName, a.Schema()),
+	}
+	usedTags := make(map[int]bool)
+	var allFields []fieldInfo
+
+	if a.ProtoMessage() {
+		allFields = append(allFields, fieldInfo{edge: a.Target, pf: &PbField{
+			Name: a.ProtoField(),
+			Type: a.TargetName(),
+		}})
+	} else if a.ProtoID() {
+		pf := &PbField{
+			Name: a.ProtoField(),
+			Type: edgeIDProtoType(a.Target),
+		}
+		if edgeIDType(a.Target) == "uuid.UUID" {
+			pf.Rules = ".string.uuid = true"
+		}
+		allFields = append(allFields, fieldInfo{edge: a.Target, pf: pf})
+	}
+
+	for _, fld := range a.Fields {
+		if isFieldProtoExclude(fld, e.conf.KeepSensitiveInBiz) {
+			continue
+		}
+		pf := &PbField{
+			Name:    fld.Name,
+			Rules:   getValidateRules(fld, a.Edge.Type, e.conf.ProtoValidator),
+			Comment: fld.Comment(),
+		}
+		if an := getFieldAnnotation(fld); an != nil && an.ProtoName != "" {
+			pf.Name = an.ProtoName
+		}
+		if fld.IsEnum() && isExternalEnum(fld) {
+			pf.Type = "string"
+		} else {
+			pf.Type = e.resolveProtoType(fld, a.Schema(), f)
+		}
+		pf.Repeated = isSlice(fld)
+		allFields = append(allFields, fieldInfo{field: fld, pf: pf})
+	}
+
+	e.assignProtoTags(msg, allFields, usedTags)
+	return msg
+}
+
 func (e *Generator) assignProtoTags(msg *PbMessage, allFields []fieldInfo, usedTags map[int]bool) {
 	currentTag := 1
 	for _, info := range allFields {
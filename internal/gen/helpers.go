@@ -1,6 +1,8 @@
 package gen
 
 import (
+	"encoding/json"
+	"fmt"
 	"sort"
 	"strings"
 
@@ -90,8 +92,12 @@ func edgeIDType(e *entgen.Edge) string { return e.Type.ID.Type.String() }
 
 func edgeProtoType(e *entgen.Edge) string {
 	if isProtoMessage(e) {
-		return e.Type.Name
+		return edgeTypeName(e)
 	}
+	return edgeIDProtoType(e)
+}
+
+func edgeIDProtoType(e *entgen.Edge) string {
 	t := e.Type.ID.Type.String()
 	switch t {
 	case "int", "int32":
@@ -191,15 +197,19 @@ func getProtoTag(f *entgen.Field, i int) int {
 	return i + 1
 }
 
+func protoFieldName(f *entgen.Field) string {
+	a := getFieldAnnotation(f)
+	if a != nil && a.ProtoName != "" {
+		return a.ProtoName
+	}
+	return f.Name
+}
+
 func protoGoName(f *entgen.Field) string {
 	if f == nil {
 		return ""
 	}
-	a := getFieldAnnotation(f)
-	if a != nil && a.ProtoName != "" {
-		return pascal(a.ProtoName)
-	}
-	return pascal(f.Name)
+	return pascal(protoFieldName(f))
 }
 
 func getEnumValues(f *entgen.Field) map[string]int32 {
@@ -311,12 +321,54 @@ func decodeAnnotationMap(m map[string]interface{}) *types.Annotation {
 		}
 	}
 
+	if v, ok := m["edge_methods"].([]interface{}); ok {
+		for _, item := range v {
+			if method, ok := item.(string); ok {
+				a.EdgeMethods = append(a.EdgeMethods, types.EdgeMethod(method))
+			}
+		}
+	}
 	if v, ok := m["biz_name"]; ok {
 		a.BizName, _ = v.(string)
 	} else if v, ok := m["BizName"]; ok {
 		a.BizName, _ = v.(string)
 	}
 
+	if v, ok := m["biz_exclude"]; ok {
+		a.BizExclude, _ = v.(bool)
+	}
+	if v, ok := m["proto_exclude"]; ok {
+		a.ProtoExclude, _ = v.(bool)
+	}
+	if v, ok := m["proto_read_only"]; ok {
+		a.ProtoReadOnly, _ = v.(bool)
+	}
+	if v, ok := m["version_field"]; ok {
+		a.VersionField, _ = v.(bool)
+	}
+	if v, ok := m["version_optional"]; ok {
+		a.VersionOptional, _ = v.(bool)
+	}
+	if v, ok := m["soft_delete"]; ok {
+		a.SoftDelete, _ = v.(bool)
+	}
+	if v, ok := m["soft_delete_in_proto"]; ok {
+		a.SoftDeleteInProto, _ = v.(bool)
+	}
+	if v, ok := m["tenant"]; ok {
+		a.Tenant, _ = v.(bool)
+	}
+	if v, ok := m["validation"].(map[string]interface{}); ok {
+		a.Validation = decodeValidation(v)
+	}
+	if v, ok := m["visible_to"].([]interface{}); ok {
+		for _, item := range v {
+			if role, ok := item.(string); ok {
+				a.VisibleTo = append(a.VisibleTo, role)
+			}
+		}
+	}
+
 	if v, ok := m["biz_type"]; ok {
 		a.BizType, _ = v.(string)
 	} else if v, ok := m["BizType"]; ok {
@@ -339,21 +391,55 @@ func decodeAnnotationMap(m map[string]interface{}) *types.Annotation {
 }
 
 func getAnnotation(e *entgen.Edge) *types.Annotation {
-	if e.Annotations == nil {
+	return lookupAnnotation(e.Annotations)
+}
+
+func getSchemaAnnotation(t *entgen.Type) *types.Annotation {
+	if t == nil {
 		return nil
 	}
-	if v, ok := e.Annotations["LazyEnt"]; ok {
-		if a, ok := v.(types.Annotation); ok {
-			return &a
+	return lookupAnnotation(t.Annotations)
+}
+
+// checkSchemaAnnotations reports naming options on schema or global
+// annotations: unlike strategies and validation rules they name a single
+// field or edge, so they have no schema or global default.
+func checkSchemaAnnotations(g *entgen.Graph) error {
+	for _, n := range g.Nodes {
+		if a := getSchemaAnnotation(n); a != nil && (a.BizName != "" || a.ProtoName != "") {
+			return fmt.Errorf("lazyent: %s: BizName and ProtoName only apply to fields and edges, not to schema annotations", n.Name)
 		}
-		if m, ok := v.(map[string]interface{}); ok {
-			return decodeEdgeAnnotationMap(m)
+		if a := getGlobalAnnotation(n); a != nil && (a.BizName != "" || a.ProtoName != "") {
+			return fmt.Errorf("lazyent: BizName and ProtoName only apply to fields and edges, not to global annotations")
 		}
 	}
-	if v, ok := e.Annotations["lazyent"]; ok {
+	return nil
+}
+
+// getGlobalAnnotation returns the global annotation injected by entc.Annotations
+// or Extension.Annotations().
+func getGlobalAnnotation(t *entgen.Type) *types.Annotation {
+	if t == nil || t.Config == nil {
+		return nil
+	}
+	return lookupAnnotation(t.Config.Annotations)
+}
+
+func lookupAnnotation(ants map[string]interface{}) *types.Annotation {
+	if ants == nil {
+		return nil
+	}
+	for _, name := range []string{"LazyEnt", "lazyent"} {
+		v, ok := ants[name]
+		if !ok {
+			continue
+		}
 		if a, ok := v.(types.Annotation); ok {
 			return &a
 		}
+		if a, ok := v.(*types.Annotation); ok {
+			return a
+		}
 		if m, ok := v.(map[string]interface{}); ok {
 			return decodeEdgeAnnotationMap(m)
 		}
@@ -361,6 +447,27 @@ func getAnnotation(e *entgen.Edge) *types.Annotation {
 	return nil
 }
 
+// edgeAnnotations returns the annotations applying to an edge, by precedence:
+// edge > schema > global.
+func edgeAnnotations(e *entgen.Edge) []*types.Annotation {
+	n := edgeNode(e)
+	return []*types.Annotation{getAnnotation(e), getSchemaAnnotation(n), getGlobalAnnotation(n)}
+}
+
+// decodeValidation decodes the structured validation rules of an annotation
+// map, which mirror the JSON encoding of types.ValidationRules.
+func decodeValidation(m map[string]interface{}) *types.ValidationRules {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil
+	}
+	var v types.ValidationRules
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil
+	}
+	return &v
+}
+
 func decodeEdgeAnnotationMap(m map[string]interface{}) *types.Annotation {
 	a := &types.Annotation{}
 	if es, ok := m["edge_field_strategy"]; ok {
@@ -370,6 +477,53 @@ func decodeEdgeAnnotationMap(m map[string]interface{}) *types.Annotation {
 			a.EdgeFieldStrategy = types.EdgeFieldStrategy(i)
 		}
 	}
+	if v, ok := m["edge_strategy_set"]; ok {
+		a.EdgeStrategySet, _ = v.(bool)
+	}
+	if v, ok := m["through_set"]; ok {
+		a.ThroughSet, _ = v.(bool)
+	}
+	if ts, ok := m["through_strategy"]; ok {
+		if f, ok := ts.(float64); ok {
+			a.ThroughStrategy = types.EdgeThroughStrategy(int(f))
+		} else if i, ok := ts.(int); ok {
+			a.ThroughStrategy = types.EdgeThroughStrategy(i)
+		}
+	}
+	if v, ok := m["edge_count"]; ok {
+		a.EdgeCount, _ = v.(bool)
+	}
+	if v, ok := m["validation"].(map[string]interface{}); ok {
+		a.Validation = decodeValidation(v)
+	}
+	if v, ok := m["visible_to"].([]interface{}); ok {
+		for _, item := range v {
+			if role, ok := item.(string); ok {
+				a.VisibleTo = append(a.VisibleTo, role)
+			}
+		}
+	}
+	if v, ok := m["crud_methods"].([]interface{}); ok {
+		for _, item := range v {
+			if method, ok := item.(string); ok {
+				a.CRUDMethods = append(a.CRUDMethods, types.CRUDMethod(method))
+			}
+		}
+	}
+	if v, ok := m["repo_methods"].([]interface{}); ok {
+		for _, item := range v {
+			if method, ok := item.(string); ok {
+				a.RepoMethods = append(a.RepoMethods, types.RepoMethod(method))
+			}
+		}
+	}
+	if v, ok := m["edge_methods"].([]interface{}); ok {
+		for _, item := range v {
+			if method, ok := item.(string); ok {
+				a.EdgeMethods = append(a.EdgeMethods, types.EdgeMethod(method))
+			}
+		}
+	}
 	if v, ok := m["biz_name"]; ok {
 		a.BizName, _ = v.(string)
 	} else if v, ok := m["BizName"]; ok {
@@ -381,17 +535,130 @@ func decodeEdgeAnnotationMap(m map[string]interface{}) *types.Annotation {
 	} else if v, ok := m["ProtoName"]; ok {
 		a.ProtoName, _ = v.(string)
 	}
+	if v, ok := m["stream"]; ok {
+		a.Stream, _ = v.(bool)
+	}
+	if v, ok := m["resource"].(map[string]interface{}); ok {
+		r := &types.Resource{}
+		r.Type, _ = v["type"].(string)
+		r.Collection, _ = v["collection"].(string)
+		r.Parent, _ = v["parent"].(string)
+		r.NameField, _ = v["name_field"].(string)
+		a.Resource = r
+	}
 	return a
 }
 
+// getStrategy returns the effective strategy of an edge, hiding the side of an
+// edge.Through pair that is not selected by its ThroughStrategy.
 func getStrategy(e *entgen.Edge) types.EdgeFieldStrategy {
-	a := getAnnotation(e)
-	if a != nil {
-		return a.EdgeFieldStrategy
+	if throughM2M(e) != nil {
+		if getThroughStrategy(e) == types.ThroughPlainList {
+			return types.BizExcludeWithProtoExclude
+		}
+		return types.BizPointerWithProtoMessage
+	}
+	if e.Through != nil && getThroughStrategy(e) == types.ThroughAssociationList {
+		return types.BizExcludeWithProtoExclude
+	}
+	return annotatedStrategy(e)
+}
+
+// annotatedStrategy returns the strategy declared on the edge, its schema or
+// globally, in that order.
+func annotatedStrategy(e *entgen.Edge) types.EdgeFieldStrategy {
+	for _, a := range edgeAnnotations(e) {
+		if a != nil && a.HasEdgeFieldStrategy() {
+			return a.EdgeFieldStrategy
+		}
 	}
 	return types.BizPointerWithProtoMessage
 }
 
+func getThroughStrategy(e *entgen.Edge) types.EdgeThroughStrategy {
+	for _, a := range edgeAnnotations(e) {
+		if a != nil && a.HasThroughStrategy() {
+			return a.ThroughStrategy
+		}
+	}
+	return types.ThroughPlainList
+}
+
+// edgeNode returns the type that declares the edge. Unlike Edge.Owner, it is
+// not the assoc type for inverse edges.
+func edgeNode(e *entgen.Edge) *entgen.Type {
+	if e.IsInverse() && e.Ref != nil {
+		return e.Ref.Type
+	}
+	return e.Owner
+}
+
+// throughM2M returns the M2M edge that the auto-generated edge e (pointing to
+// an edge schema, e.g. Group.memberships) goes through, or nil.
+func throughM2M(e *entgen.Edge) *entgen.Edge {
+	if e.Type.EdgeSchema.To == nil && e.Type.EdgeSchema.From == nil {
+		return nil
+	}
+	n := edgeNode(e)
+	if n == nil {
+		return nil
+	}
+	for _, m := range n.Edges {
+		if m != e && m.Through == e.Type {
+			return m
+		}
+	}
+	return nil
+}
+
+func isThroughEdge(e *entgen.Edge) bool {
+	return throughM2M(e) != nil
+}
+
+// edgeTypeName returns the biz/proto type name an edge points to. Edges to an
+// edge schema point to the association type (e.g. GroupMembership).
+func edgeTypeName(e *entgen.Edge) string {
+	if isThroughEdge(e) {
+		return edgeNode(e).Name + e.Type.Name
+	}
+	return e.Type.Name
+}
+
+func hasEdgeCount(e *entgen.Edge) bool {
+	if e.Unique || isThroughEdge(e) {
+		return false
+	}
+	a := getAnnotation(e)
+	return a != nil && a.EdgeCount
+}
+
+// edgeCountAggregate returns the O2M edge whose foreign key column can be
+// grouped to count e for a page of parents in one query: e itself, or the
+// edge to the edge schema of an edge.Through M2M edge. It returns nil for
+// plain M2M edges, which are grouped over their join table instead.
+func edgeCountAggregate(e *entgen.Edge) *entgen.Edge {
+	if e.O2M() {
+		return e
+	}
+	if e.Through == nil {
+		return nil
+	}
+	for _, te := range edgeNode(e).Edges {
+		if te.Type == e.Through && isThroughEdge(te) {
+			return te
+		}
+	}
+	return nil
+}
+
+func edgeCountName(e *entgen.Edge) string {
+	return entgen.Funcs["singular"].(func(string) string)(e.StructField()) + "Count"
+}
+
+func edgeCountProtoName(e *entgen.Edge) string {
+	return entgen.Funcs["snake"].(func(string) string)(edgeCountName(e))
+}
+
 func isBizIDOnly(e *entgen.Edge) bool {
 	s := getStrategy(e)
 	return s == types.BizIDWithProtoID || s == types.BizIDWithProtoExclude
@@ -414,6 +681,44 @@ func isSensitive(f *entgen.Field) bool {
 	return f.Sensitive()
 }
 
+// isFieldBizExclude reports whether a field is left out of biz and the Ent <-> Biz
+// mappers. Sensitive fields are left out unless keepSensitive (Config.KeepSensitiveInBiz).
+func isFieldBizExclude(f *entgen.Field, keepSensitive bool) bool {
+	if a := getFieldAnnotation(f); a != nil && a.BizExclude {
+		return true
+	}
+	return isSensitive(f) && !keepSensitive
+}
+
+// isFieldProtoExclude reports whether a field is left out of proto. Sensitive
+// fields never reach proto, even when kept in biz, and soft-delete fields only
+// when annotated with WithSoftDeleteInProto.
+func isFieldProtoExclude(f *entgen.Field, keepSensitive bool) bool {
+	if isSensitive(f) || isFieldBizExclude(f, keepSensitive) {
+		return true
+	}
+	a := getFieldAnnotation(f)
+	return a != nil && (a.ProtoExclude || a.SoftDelete && !a.SoftDeleteInProto)
+}
+
+// isFieldProtoReadOnly reports whether a proto field is ignored when mapping
+// proto to biz. Tenant fields are always read-only: the tenant is taken from
+// the context.
+func isFieldProtoReadOnly(f *entgen.Field) bool {
+	a := getFieldAnnotation(f)
+	return a != nil && (a.ProtoReadOnly || a.Tenant)
+}
+
+func redactedFields(fields []*entgen.Field, keepSensitive bool) []*entgen.Field {
+	var res []*entgen.Field
+	for _, f := range fields {
+		if isSensitive(f) && !isFieldBizExclude(f, keepSensitive) {
+			res = append(res, f)
+		}
+	}
+	return res
+}
+
 func isProtoID(e *entgen.Edge) bool {
 	s := getStrategy(e)
 	return s == types.BizPointerWithProtoID || s == types.BizIDWithProtoID
@@ -572,12 +877,16 @@ func camel(s string) string {
 	return string(r)
 }
 
-func protoStructField(e *entgen.Edge) string {
+func protoEdgeName(e *entgen.Edge) string {
 	a := getAnnotation(e)
 	if a != nil && a.ProtoName != "" {
-		return pascal(a.ProtoName)
+		return a.ProtoName
 	}
-	return pascal(e.Name)
+	return e.Name
+}
+
+func protoStructField(e *entgen.Edge) string {
+	return pascal(protoEdgeName(e))
 }
 
 func isExternalEnum(f *entgen.Field) bool {
@@ -0,0 +1,322 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	entgen "entgo.io/ent/entc/gen"
+	"github.com/Cromemadnd/lazyent/internal/types"
+)
+
+// filterKind classifies the operators available to a list filter field.
+type filterKind int
+
+const (
+	filterString filterKind = iota // eq, in, contains
+	filterExact                    // eq, in on strings (UUIDs and external enums)
+	filterEnum                     // eq, in on the proto enum
+	filterBool                     // eq
+	filterNumber                   // eq, in, gt, gte, lt, lte
+	filterTime                     // eq, in, gt, gte, lt, lte on timestamps
+)
+
+type FilterOp struct {
+	Proto    string // Proto field name, e.g. gte
+	Name     string // Biz field and ent predicate suffix, e.g. GTE
+	Repeated bool   // Operand is a list (in)
+}
+
+var (
+	opEQ       = FilterOp{Proto: "eq", Name: "EQ"}
+	opIn       = FilterOp{Proto: "in", Name: "In", Repeated: true}
+	opContains = FilterOp{Proto: "contains", Name: "Contains"}
+	opGT       = FilterOp{Proto: "gt", Name: "GT"}
+	opGTE      = FilterOp{Proto: "gte", Name: "GTE"}
+	opLT       = FilterOp{Proto: "lt", Name: "LT"}
+	opLTE      = FilterOp{Proto: "lte", Name: "LTE"}
+)
+
+var filterOps = map[filterKind][]FilterOp{
+	filterString: {opEQ, opIn, opContains},
+	filterExact:  {opEQ, opIn},
+	filterEnum:   {opEQ, opIn},
+	filterBool:   {opEQ},
+	filterNumber: {opEQ, opIn, opGT, opGTE, opLT, opLTE},
+	filterTime:   {opEQ, opIn, opGT, opGTE, opLT, opLTE},
+}
+
+var numericProtoTypes = map[string]bool{
+	"int32": true, "int64": true, "uint32": true, "uint64": true, "double": true, "float": true,
+}
+
+// ListFilter is a field of the XFilter message, filtering on the node ID or
+// on one of its fields.
+type ListFilter struct {
+	Field *entgen.Field
+	ID    bool
+	Kind  filterKind
+}
+
+func (lf ListFilter) Ops() []FilterOp { return filterOps[lf.Kind] }
+
+// hasList reports whether list options (filter, ordering and pagination) are
+// generated for the node: it has a ListXs RPC, a List repository method, is
+// listed by an edge sub-resource or is streamed, as streams take its filter.
+func (e *Generator) hasList(n *entgen.Type) bool {
+	listed, _ := e.edgeListed(n)
+	return n.ID != nil && (hasCRUDMethod(n, types.CRUDList) || hasRepoMethod(n, types.RepoList) || listed || hasStreamRPC(n) || hasStreamRepo(n))
+}
+
+// hasProtoList reports whether the list options of the node are part of the
+// proto API, i.e. taken by a ListXs, an edge sub-resource list or a stream RPC.
+func (e *Generator) hasProtoList(n *entgen.Type) bool {
+	_, rpc := e.edgeListed(n)
+	return n.ID != nil && (hasCRUDMethod(n, types.CRUDList) || rpc || hasStreamRPC(n))
+}
+
+func (e *Generator) hasListNodes(nodes []interface{}) bool {
+	for _, nd := range nodes {
+		if m, ok := nd.(map[string]interface{}); ok {
+			if n, ok := m["Type"].(*entgen.Type); ok && e.hasList(n) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// filterKindOf returns the filter kind of a field, or false if the field
+// cannot be filtered on.
+func filterKindOf(f *entgen.Field) (filterKind, bool) {
+	if f.IsEnum() {
+		if isExternalEnum(f) {
+			return filterExact, true
+		}
+		return filterEnum, true
+	}
+	bizType := explicitBizType(f)
+	switch f.Type.String() {
+	case "string":
+		return filterString, true
+	case "bool":
+		return filterBool, true
+	case "uuid.UUID":
+		return filterExact, bizType == "" || bizType == "string"
+	case "time.Time":
+		return filterTime, bizType == ""
+	}
+	if numericProtoTypes[getProtoType(f)] && bizZeroCond(f, "v", true) == "v == 0" {
+		return filterNumber, true
+	}
+	return 0, false
+}
+
+// listFilters returns the filters of a node: its ID, then the fields visible
+// in proto holding comparable values.
+func listFilters(n *entgen.Type, keepSensitive bool) []ListFilter {
+	res := []ListFilter{{Field: n.ID, ID: true, Kind: filterExact}}
+	for _, f := range n.Fields {
+		if isFieldProtoExclude(f, keepSensitive) || f.IsJSON() || isTenantField(f) {
+			continue
+		}
+		if kind, ok := filterKindOf(f); ok {
+			res = append(res, ListFilter{Field: f, Kind: kind})
+		}
+	}
+	return res
+}
+
+// listOrderFields returns the fields a list can be ordered by besides its ID:
+// required string, number and time fields visible in proto. Nullable columns
+// are left out as they cannot serve as a keyset cursor.
+func listOrderFields(n *entgen.Type, keepSensitive bool) []*entgen.Field {
+	var res []*entgen.Field
+	for _, lf := range listFilters(n, keepSensitive) {
+		f := lf.Field
+		if lf.ID || f.Optional || f.Nillable {
+			continue
+		}
+		if lf.Kind == filterString || lf.Kind == filterNumber || lf.Kind == filterTime {
+			res = append(res, f)
+		}
+	}
+	return res
+}
+
+func filterBizName(lf ListFilter) string {
+	if lf.ID {
+		return "UUID"
+	}
+	return bizFieldName(lf.Field)
+}
+
+func filterBizType(lf ListFilter, nodeName, qual string) string {
+	t := "string"
+	switch {
+	case lf.Kind == filterEnum:
+		t = qual + bizValueType(lf.Field, nodeName)
+	case !lf.ID:
+		t = bizValueType(lf.Field, nodeName)
+	}
+	switch lf.Kind {
+	case filterString:
+		return qual + "StringFilter"
+	case filterBool:
+		return qual + "BoolFilter"
+	case filterNumber, filterTime:
+		return fmt.Sprintf("%sRangeFilter[%s]", qual, t)
+	}
+	return fmt.Sprintf("%sValueFilter[%s]", qual, t)
+}
+
+func filterProtoMessage(lf ListFilter, nodeName string) string {
+	switch lf.Kind {
+	case filterString:
+		return "StringFilter"
+	case filterExact:
+		return "ExactStringFilter"
+	case filterEnum:
+		return nodeName + lf.Field.StructField() + "Filter"
+	case filterBool:
+		return "BoolFilter"
+	case filterTime:
+		return "TimestampFilter"
+	}
+	return pascal(getProtoType(lf.Field)) + "Filter"
+}
+
+func orderEnumName(n *entgen.Type) string {
+	return n.Name + "OrderBy"
+}
+
+func orderEnumValue(n *entgen.Type, f *entgen.Field) string {
+	if f == nil {
+		return strings.ToUpper(orderEnumName(n)) + "_UNSPECIFIED"
+	}
+	return strings.ToUpper(orderEnumName(n)) + "_" + strings.ToUpper(protoFieldName(f))
+}
+
+// filterFromProto generates the statements converting the proto operator
+// message v of a filter into the biz filter x.
+func filterFromProto(lf ListFilter, nodeName string) string {
+	conv := func(expr string) string {
+		if lf.ID {
+			return expr
+		}
+		return convertFromProtoExpr(lf.Field, nodeName, expr)
+	}
+	var b strings.Builder
+	for _, op := range lf.Ops() {
+		src, dst := "v."+pascal(op.Proto), "x."+op.Name
+		switch {
+		case op.Repeated && conv("item") == "item":
+			fmt.Fprintf(&b, "%s = %s\n", dst, src)
+		case op.Repeated:
+			fmt.Fprintf(&b, "for _, item := range %s {\n\t%s = append(%s, %s)\n}\n", src, dst, dst, conv("item"))
+		case lf.Kind == filterTime:
+			fmt.Fprintf(&b, "if %s != nil {\n\tval := %s\n\t%s = &val\n}\n", src, conv(src), dst)
+		case conv("*"+src) == "*"+src:
+			fmt.Fprintf(&b, "%s = %s\n", dst, src)
+		default:
+			fmt.Fprintf(&b, "if %s != nil {\n\tval := %s\n\t%s = &val\n}\n", src, conv("*"+src), dst)
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// filterToEnt generates the statements appending the ent predicates of the
+// biz filter v to ps.
+func filterToEnt(lf ListFilter, n *entgen.Type) string {
+	pred := n.Package() + "."
+	if lf.ID {
+		pred += "ID"
+	} else {
+		pred += lf.Field.StructField()
+	}
+	// parse returns the statements converting the biz value src into val, or
+	// "" with the converted expression.
+	parse := func(src string) (string, string) {
+		if lf.ID {
+			return entIDFromBiz(lf.Field, n.Name, src, "val", "nil, "), "val"
+		}
+		if stmt := parseFieldFromBiz(lf.Field, n.Name, src, "val", "nil, "); stmt != "" {
+			return stmt, "val"
+		}
+		return "", convertBizToEntValue(lf.Field, n.Name, src)
+	}
+	var b strings.Builder
+	for _, op := range lf.Ops() {
+		src, p := "v."+op.Name, pred+op.Name
+		if op.Repeated {
+			stmt, expr := parse("item")
+			if stmt == "" && expr == "item" {
+				fmt.Fprintf(&b, "if len(%s) > 0 {\n\tps = append(ps, %s(%s...))\n}\n", src, p, src)
+				continue
+			}
+			if stmt != "" {
+				stmt = indent(stmt, 2) + "\n"
+			}
+			fmt.Fprintf(&b, "if len(%s) > 0 {\n\tvals := make([]%s, 0, len(%s))\n\tfor _, item := range %s {\n%s\t\tvals = append(vals, %s)\n\t}\n\tps = append(ps, %s(vals...))\n}\n",
+				src, lf.Field.Type.String(), src, src, stmt, expr, p)
+			continue
+		}
+		stmt, expr := parse("*" + src)
+		if stmt != "" {
+			stmt = indent(stmt, 1) + "\n"
+		}
+		fmt.Fprintf(&b, "if %s != nil {\n%s\tps = append(ps, %s(%s))\n}\n", src, stmt, p, expr)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func indent(s string, n int) string {
+	prefix := strings.Repeat("\t", n)
+	return prefix + strings.ReplaceAll(s, "\n", "\n"+prefix)
+}
+
+// buildListMessages appends the filter message, order enum and the operator
+// messages they use to the file, once per node.
+func (e *Generator) buildListMessages(n *entgen.Type, f *PbFile) {
+	if f.hasMessage(n.Name + "Filter") {
+		return
+	}
+	filter := &PbMessage{Name: n.Name + "Filter", Comment: fmt.Sprintf("%s 列表过滤条件，未设置的字段不参与过滤", n.Name)}
+	for i, lf := range listFilters(n, e.conf.KeepSensitiveInBiz) {
+		name := filterProtoMessage(lf, n.Name)
+		filter.Fields = append(filter.Fields, &PbField{Name: protoFieldName(lf.Field), Type: name, Tag: i + 1})
+		if f.hasMessage(name) {
+			continue
+		}
+		scalar := "string"
+		if !lf.ID && lf.Kind != filterExact {
+			scalar = e.resolveProtoType(lf.Field, n.Name, f)
+		}
+		msg := &PbMessage{Name: name}
+		for j, op := range lf.Ops() {
+			msg.Fields = append(msg.Fields, &PbField{
+				Name:     op.Proto,
+				Type:     scalar,
+				Tag:      j + 1,
+				Repeated: op.Repeated,
+				Optional: !op.Repeated && lf.Kind != filterTime,
+			})
+		}
+		f.Elements = append(f.Elements, PbElement{Message: msg})
+	}
+	f.Elements = append(f.Elements, PbElement{Message: filter})
+
+	order := &PbEnum{Name: orderEnumName(n), Values: []*PbEnumValue{{Name: orderEnumValue(n, nil)}}}
+	for i, fld := range listOrderFields(n, e.conf.KeepSensitiveInBiz) {
+		order.Values = append(order.Values, &PbEnumValue{Name: orderEnumValue(n, fld), Number: int32(i + 1)})
+	}
+	f.Elements = append(f.Elements, PbElement{Enum: order})
+}
+
+func (f *PbFile) hasMessage(name string) bool {
+	for _, el := range f.Elements {
+		if el.Message != nil && el.Message.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package gen
+
+import (
+	"fmt"
+
+	entgen "entgo.io/ent/entc/gen"
+)
+
+// MaskPath is a FieldMask path of UpdateXRequest, resolved to the field or
+// edge it updates.
+type MaskPath struct {
+	Path  string        // Proto field name, e.g. user_score
+	Field *entgen.Field // Updated field, nil for edges
+	Edge  *entgen.Edge  // Updated edge, nil for fields
+	Owner string        // Where a read-only edge is updated instead, e.g. Post.author
+}
+
+func updateMaskPaths(n *entgen.Type, keepSensitive bool) []MaskPath {
+	var res []MaskPath
+	for _, f := range updateInputFields(n, keepSensitive) {
+		res = append(res, MaskPath{Path: protoFieldName(f), Field: f})
+	}
+	for _, e := range inputEdges(n, true) {
+		res = append(res, MaskPath{Path: protoEdgeName(e), Edge: e})
+	}
+	return res
+}
+
+// immutableMaskPaths returns the proto paths that exist in the entity message
+// but cannot be updated: the ID, Immutable and server-managed fields, and
+// Immutable edges.
+func immutableMaskPaths(n *entgen.Type, keepSensitive bool) []string {
+	var res []string
+	if n.ID != nil {
+		res = append(res, protoFieldName(n.ID))
+	}
+	for _, f := range n.Fields {
+		if isFieldProtoExclude(f, keepSensitive) {
+			continue
+		}
+		if f.Immutable || isServerManaged(f) {
+			res = append(res, protoFieldName(f))
+		}
+	}
+	for _, e := range n.Edges {
+		if isProtoID(e) && e.Immutable && e.Field() == nil {
+			res = append(res, protoEdgeName(e))
+		}
+	}
+	return res
+}
+
+// ownedMaskPaths returns the edge paths that exist in the entity message but
+// are updated through other entities: edges required by their targets, set on
+// the inverse edge, and edges through an edge schema, set by creating or
+// deleting its entities.
+func ownedMaskPaths(n *entgen.Type) []MaskPath {
+	var res []MaskPath
+	for _, e := range n.Edges {
+		if !isProtoID(e) || isBizExclude(e) || e.Immutable || e.Field() != nil {
+			continue
+		}
+		var owner string
+		switch {
+		case e.Through != nil:
+			owner = e.Through.Name
+		case isThroughEdge(e):
+			owner = e.Type.Name
+		case requiredByTarget(e):
+			owner = e.Type.Name + "." + e.Ref.Name
+		default:
+			continue
+		}
+		res = append(res, MaskPath{Path: protoEdgeName(e), Edge: e, Owner: owner})
+	}
+	return res
+}
+
+// protoPresenceCond returns the condition telling whether an UpdateXRequest
+// field is present.
+func protoPresenceCond(f *entgen.Field) string {
+	if isSlice(f) {
+		return fmt.Sprintf("len(p.%s) > 0", protoGoName(f))
+	}
+	return fmt.Sprintf("p.%s != nil", protoGoName(f))
+}
+
+// protoEdgePresenceCond returns the condition telling whether UpdateXRequest
+// edge IDs are present.
+func protoEdgePresenceCond(e *entgen.Edge) string {
+	if e.Unique {
+		return fmt.Sprintf("p.%s != nil", protoStructField(e))
+	}
+	return fmt.Sprintf("len(p.%s) > 0", protoStructField(e))
+}
+
+// applyFieldFromBiz generates the statements calling the UpdateOne setter of
+// a field with its biz value. Optional fields holding zero values are cleared,
+// other fields parsed from zero values are left unchanged.
+func applyFieldFromBiz(f *entgen.Field, nodeName string) string {
+	return updateFieldSetter(f, nodeName, "")
+}
+
+// applyEdgeFromBiz generates the statements replacing the IDs of an edge with
+// those held by the biz entity.
+func applyEdgeFromBiz(e *entgen.Edge) string {
+	return updateEdgeSetter(e, "")
+}
@@ -0,0 +1,140 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	entgen "entgo.io/ent/entc/gen"
+	"github.com/Cromemadnd/lazyent/internal/types"
+)
+
+// repoMethods returns the repository methods selected by the schema annotation
+// and those needed by its CRUD methods, in canonical order.
+func repoMethods(n *entgen.Type) []types.RepoMethod {
+	a := getSchemaAnnotation(n)
+	if a == nil || len(a.RepoMethods) == 0 {
+		return nil
+	}
+	wants := append([]types.RepoMethod(nil), a.RepoMethods...)
+	// The usecase serving the CRUD service needs the repository methods behind
+	// each of its CRUD methods.
+	for _, m := range crudMethods(n) {
+		wants = append(wants, usecaseRepoMethods[m]...)
+	}
+	var res []types.RepoMethod
+	for _, m := range types.AllRepoMethods {
+		for _, want := range wants {
+			if m == want {
+				res = append(res, m)
+				break
+			}
+		}
+	}
+	return res
+}
+
+func hasRepoMethod(n *entgen.Type, m types.RepoMethod) bool {
+	for _, v := range repoMethods(n) {
+		if v == m {
+			return true
+		}
+	}
+	return false
+}
+
+func hasRepo(n *entgen.Type) bool {
+	return n.ID != nil && len(repoMethods(n)) > 0
+}
+
+func hasRepoNodes(g *entgen.Graph) bool {
+	for _, n := range g.Nodes {
+		if hasRepo(n) {
+			return true
+		}
+	}
+	return false
+}
+
+func bizValueType(f *entgen.Field, nodeName string) string {
+	if !f.IsEnum() {
+		return bizFieldType(f)
+	}
+	if isExternalEnum(f) {
+		return getExternalEnumName(f)
+	}
+	return nodeName + f.StructField()
+}
+
+// repoEdges returns the non-unique edges kept in biz, each listed by a
+// ListXY method. Edges to edge schemas are listed through their M2M edge,
+// edges with a list sub-resource by its paginated ListXY method.
+func repoEdges(n *entgen.Type) []*entgen.Edge {
+	var res []*entgen.Edge
+	for _, e := range n.Edges {
+		if e.Unique || isBizExclude(e) || isThroughEdge(e) || hasEdgeMethod(e, types.EdgeList) {
+			continue
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+func repoEdgeListName(n *entgen.Type, e *entgen.Edge) string {
+	return "List" + n.Name + e.StructField()
+}
+
+// eagerLoad generates the statement configuring the eager loading of an edge
+// on the query q from its strategy: biz pointers load their targets, biz IDs
+// only select the target IDs and edges excluded from biz are not loaded.
+// Edges to an edge schema also load the other side of biz pointer associations.
+// Tenant targets are only loaded when the EdgeScope scope has a tenant and are
+// limited to it, and soft-deleted targets are skipped unless the scope
+// includes them.
+func eagerLoad(e *entgen.Edge) string {
+	if isBizExclude(e) {
+		return ""
+	}
+	var body []string
+	if p := edgeTenantPredicate(e); p != "" {
+		body = append(body, fmt.Sprintf("q.Where(%s)", p))
+	}
+	if p := edgeSoftDeletePredicate(e); p != "" {
+		body = append(body, fmt.Sprintf("if !scope.IncludeDeleted {\n\tq.Where(%s)\n}", p))
+	}
+	switch {
+	case isThroughEdge(e):
+		if a, ok := newAssocDef(e, false); ok && a.BizPointer() {
+			body = append(body, fmt.Sprintf("q.With%s()", a.Target.StructField()))
+		}
+	case isBizIDOnly(e):
+		body = append(body, fmt.Sprintf("q.Select(%s.%s)", e.Type.Package(), e.Type.ID.Constant()))
+	}
+	with := "q.With" + e.StructField()
+	if len(body) == 0 {
+		return with + "()"
+	}
+	s := fmt.Sprintf("%s(func(q *ent.%sQuery) {\n\t%s\n})", with, e.Type.Name, strings.ReplaceAll(strings.Join(body, "\n"), "\n", "\n\t"))
+	if edgeTenantPredicate(e) != "" {
+		s = fmt.Sprintf("if scope.HasTenant {\n\t%s\n}", strings.ReplaceAll(s, "\n", "\n\t"))
+	}
+	return s
+}
+
+// edgeTargetPredicate returns the predicate on the query of e.Type keeping the
+// targets of e matching pred, a predicate on the target type built by target.
+// Edges to an edge schema wrap it in the HasXWith predicate of the edge schema
+// edge to the other side. It returns "" if target returns "".
+func edgeTargetPredicate(e *entgen.Edge, target func(*entgen.Type) string) string {
+	if !isThroughEdge(e) {
+		return target(e.Type)
+	}
+	a, ok := newAssocDef(e, false)
+	if !ok {
+		return ""
+	}
+	p := target(a.Target.Type)
+	if p == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s.Has%sWith(%s)", e.Type.Package(), a.Target.StructField(), p)
+}
@@ -0,0 +1,301 @@
+package gen
+
+import (
+	"fmt"
+
+	entgen "entgo.io/ent/entc/gen"
+)
+
+// isServerManaged reports whether a field is maintained by the server and never
+// accepted from clients: read-only fields, and defaulted fields that cannot be
+// changed afterwards (Immutable) or are refreshed on every update (UpdateDefault),
+// e.g. created_at and updated_at. Version fields are only advanced by updates
+// and soft-delete fields only set by Delete and cleared by Restore. Tenant
+// fields are taken from the context by the repository.
+func isServerManaged(f *entgen.Field) bool {
+	return isFieldProtoReadOnly(f) || isVersionField(f) || isSoftDeleteField(f) || isTenantField(f) || (f.Default && (f.Immutable || f.UpdateDefault))
+}
+
+// createInputID reports whether clients provide the node ID on create, i.e. the
+// ID is user defined and has no default.
+func createInputID(n *entgen.Type) bool {
+	return n.ID != nil && n.ID.UserDefined && !n.ID.Default && !isFieldProtoReadOnly(n.ID)
+}
+
+func createInputFields(n *entgen.Type, keepSensitive bool) []*entgen.Field {
+	var res []*entgen.Field
+	for _, f := range n.Fields {
+		if isFieldProtoExclude(f, keepSensitive) || isServerManaged(f) {
+			continue
+		}
+		res = append(res, f)
+	}
+	return res
+}
+
+func updateInputFields(n *entgen.Type, keepSensitive bool) []*entgen.Field {
+	var res []*entgen.Field
+	for _, f := range createInputFields(n, keepSensitive) {
+		if f.Immutable {
+			continue
+		}
+		res = append(res, f)
+	}
+	return res
+}
+
+// inputEdges returns the edges accepted by CreateXRequest/UpdateXRequest: edges
+// exposed as IDs in proto. Edges backed by an edge-field are set through the
+// field, and edge schema edges through their association. Updates skip
+// Immutable edges and those required by their targets.
+func inputEdges(n *entgen.Type, update bool) []*entgen.Edge {
+	var res []*entgen.Edge
+	for _, e := range n.Edges {
+		if !isProtoID(e) || isBizExclude(e) || e.Field() != nil || e.Through != nil || isThroughEdge(e) {
+			continue
+		}
+		if update && (e.Immutable || requiredByTarget(e)) {
+			continue
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+// hasProtoPresence reports whether the proto field of f can be declared
+// optional: singular scalars and enums. Messages (Timestamp) already track
+// presence and repeated fields cannot.
+func hasProtoPresence(f *entgen.Field) bool {
+	return !isSlice(f) && f.Type.String() != "time.Time"
+}
+
+func isCreateOptional(f *entgen.Field) bool {
+	return f.Optional || f.Nillable || f.Default
+}
+
+// patchFieldType returns the type of a field in the biz patch struct. Slices
+// use nil for "unchanged", other fields are pointers.
+func patchFieldType(f *entgen.Field, nodeName string) string {
+	t := bizValueType(f, nodeName)
+	if isSlice(f) {
+		return t
+	}
+	return "*" + t
+}
+
+func patchEdgeName(e *entgen.Edge) string {
+	if isBizIDOnly(e) {
+		return bizEdgeName(e)
+	}
+	if e.Unique {
+		return e.StructField() + "ID"
+	}
+	return entgen.Funcs["singular"].(func(string) string)(e.StructField()) + "IDs"
+}
+
+// patchEdgeType returns the type of an edge in the biz patch struct, matching
+// the ID type of the biz entity.
+func patchEdgeType(e *entgen.Edge) string {
+	if !e.Unique {
+		return "[]string"
+	}
+	if isBizIDOnly(e) {
+		return "*" + edgeIDType(e)
+	}
+	return "*string"
+}
+
+// assignFromProto generates the statements assigning the proto value expr of
+// a field to target, taking its address if ptr is set.
+func assignFromProto(f *entgen.Field, nodeName, expr, target string, ptr bool) string {
+	varName := camel(f.StructField()) + "Val"
+	ref := varName
+	if ptr {
+		ref = "&" + varName
+	}
+	if isSlice(f) {
+		if isSliceTypeMatch(f) {
+			return fmt.Sprintf("%s = %s", target, expr)
+		}
+		elem := getSliceElementType(f)
+		item := fmt.Sprintf("%s(item)", elem)
+		if elem == "time.Time" {
+			item = "item.AsTime()"
+		}
+		return fmt.Sprintf("var %s []%s\nfor _, item := range %s {\n\t%s = append(%s, %s)\n}\n%s = %s",
+			varName, elem, expr, varName, varName, item, target, varName)
+	}
+	if requiresErrorCheck(f, "ProtoToBiz") {
+		return fmt.Sprintf("%s, err := uuid.Parse(%s)\nif err != nil {\n\treturn nil, %s\n}\n%s = %s",
+			varName, expr, invalidArgumentErr(nodeName, "invalid UUID for "+f.Name), target, ref)
+	}
+	value := convertFromProtoExpr(f, nodeName, expr)
+	if !ptr {
+		return fmt.Sprintf("%s = %s", target, value)
+	}
+	if value == expr && len(expr) > 0 && expr[0] == '*' {
+		// Same type on both sides, share the proto pointer.
+		return fmt.Sprintf("%s = %s", target, expr[1:])
+	}
+	return fmt.Sprintf("%s := %s\n%s = %s", varName, value, target, ref)
+}
+
+func createFieldFromProto(f *entgen.Field, nodeName string) string {
+	src := "p." + protoGoName(f)
+	target := "b." + bizFieldName(f)
+	switch {
+	case isSlice(f) && isSliceTypeMatch(f):
+		return assignFromProto(f, nodeName, src, target, false)
+	case isSlice(f):
+		return fmt.Sprintf("if len(%s) > 0 {\n%s\n}", src, assignFromProto(f, nodeName, src, target, false))
+	case f.Type.String() == "time.Time" && !isSensitive(f):
+		return fmt.Sprintf("if %s != nil {\n%s\n}", src, assignFromProto(f, nodeName, src, target, false))
+	case isCreateOptional(f):
+		return fmt.Sprintf("if %s != nil {\n%s\n}", src, assignFromProto(f, nodeName, "*"+src, target, false))
+	}
+	return assignFromProto(f, nodeName, src, target, false)
+}
+
+// patchFieldFromProto generates the statements copying a present UpdateXRequest
+// field into patch.
+func patchFieldFromProto(f *entgen.Field, nodeName string) string {
+	src := "p." + protoGoName(f)
+	target := "patch." + bizFieldName(f)
+	switch {
+	case isSlice(f):
+		return fmt.Sprintf("if len(%s) > 0 {\n%s\n}", src, assignFromProto(f, nodeName, src, target, false))
+	case f.Type.String() == "time.Time":
+		return fmt.Sprintf("if %s != nil {\n%s\n}", src, assignFromProto(f, nodeName, src, target, true))
+	}
+	return fmt.Sprintf("if %s != nil {\n%s\n}", src, assignFromProto(f, nodeName, "*"+src, target, true))
+}
+
+// patchEdgeFromProto generates the statements copying present UpdateXRequest
+// edge IDs into patch. UUIDs are validated, int IDs are stringified.
+func patchEdgeFromProto(e *entgen.Edge) string {
+	src := "p." + protoStructField(e)
+	target := "patch." + patchEdgeName(e)
+	typ := edgeIDType(e)
+	isInt := typ == "int" || typ == "int32" || typ == "int64"
+	check := func(v string) string {
+		if typ != "uuid.UUID" {
+			return ""
+		}
+		return fmt.Sprintf("if _, err := uuid.Parse(%s); err != nil {\n\treturn nil, %s\n}\n", v, invalidArgumentErr(edgeNode(e).Name, "invalid UUID for "+e.Name))
+	}
+	if e.Unique && isBizIDOnly(e) {
+		switch {
+		case typ == "uuid.UUID":
+			return fmt.Sprintf("if %s != nil {\nid, err := uuid.Parse(*%s)\nif err != nil {\n\treturn nil, %s\n}\n%s = &id\n}", src, src, invalidArgumentErr(edgeNode(e).Name, "invalid UUID for "+e.Name), target)
+		case isInt:
+			return fmt.Sprintf("if %s != nil {\nid := %s(*%s)\n%s = &id\n}", src, typ, src, target)
+		}
+	}
+	if e.Unique {
+		if isInt {
+			return fmt.Sprintf("if %s != nil {\nid := strconv.Itoa(int(*%s))\n%s = &id\n}", src, src, target)
+		}
+		return fmt.Sprintf("if %s != nil {\n%s%s = %s\n}", src, check("*"+src), target, src)
+	}
+	if isInt {
+		return fmt.Sprintf("if len(%s) > 0 {\n%s = make([]string, 0, len(%s))\nfor _, item := range %s {\n%s = append(%s, strconv.Itoa(int(item)))\n}\n}",
+			src, target, src, src, target, target)
+	}
+	if check("") == "" {
+		return fmt.Sprintf("if len(%s) > 0 {\n%s = %s\n}", src, target, src)
+	}
+	return fmt.Sprintf("if len(%s) > 0 {\nfor _, item := range %s {\n%s}\n%s = %s\n}", src, src, check("item"), target, src)
+}
+
+func idFromProto(id *entgen.Field, expr string) string {
+	switch id.Type.String() {
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return fmt.Sprintf("strconv.FormatInt(int64(%s), 10)", expr)
+	}
+	return expr
+}
+
+func createEdgeFromProto(e *entgen.Edge) string {
+	src := "p." + protoStructField(e)
+	target := "b." + bizEdgeName(e)
+	if isBizPointer(e) {
+		return fmt.Sprintf("%s\n%s = %s", edgeStubsFromProtoSetup(e), target, camel(bizEdgeName(e)))
+	}
+	if e.Unique {
+		return fmt.Sprintf("%s = %s", target, edgeConvertFromProto(e))
+	}
+	switch edgeIDType(e) {
+	case "int", "int32", "int64":
+		return fmt.Sprintf("for _, item := range %s {\n%s = append(%s, strconv.Itoa(int(item)))\n}", src, target, target)
+	}
+	return fmt.Sprintf("%s = %s", target, src)
+}
+
+// buildCreateRequest builds CreateXRequest from the fields clients may set on
+// create. Fields that may be omitted are optional, so that unset values fall
+// back to their ent defaults.
+func (e *Generator) buildCreateRequest(n *entgen.Type, name string, f *PbFile) *PbMessage {
+	var all []fieldInfo
+	if createInputID(n) {
+		pf := e.buildProtoField(n.ID, n, f)
+		all = append(all, fieldInfo{isID: true, field: n.ID, pf: pf})
+	}
+	for _, fld := range createInputFields(n, e.conf.KeepSensitiveInBiz) {
+		pf := e.buildProtoField(fld, n, f)
+		pf.Optional = isCreateOptional(fld) && hasProtoPresence(fld)
+		all = append(all, fieldInfo{field: fld, pf: pf})
+	}
+	for _, edge := range inputEdges(n, false) {
+		all = append(all, fieldInfo{edge: edge, pf: e.buildProtoEdgeIDField(edge)})
+	}
+	return e.buildRequestMessage(name, all)
+}
+
+// buildUpdateRequest builds UpdateXRequest: the node ID followed by the
+// mutable fields and the update mask. Scalars are optional and, without a
+// mask, unset fields are left unchanged.
+func (e *Generator) buildUpdateRequest(n *entgen.Type, name string, f *PbFile) *PbMessage {
+	id := e.buildProtoField(n.ID, n, f)
+	if r := e.resources[n]; r != nil {
+		r.nameProtoField(id)
+	}
+	all := []fieldInfo{{isID: true, field: n.ID, pf: id}}
+	for _, fld := range updateInputFields(n, e.conf.KeepSensitiveInBiz) {
+		pf := e.buildProtoField(fld, n, f)
+		pf.Optional = hasProtoPresence(fld)
+		all = append(all, fieldInfo{field: fld, pf: pf})
+	}
+	for _, edge := range inputEdges(n, true) {
+		pf := e.buildProtoEdgeIDField(edge)
+		pf.Optional = edge.Unique
+		all = append(all, fieldInfo{edge: edge, pf: pf})
+	}
+	if vf := versionField(n); vf != nil {
+		pf := e.buildProtoField(vf, n, f)
+		pf.Optional = true
+		pf.Comment = "期望的当前版本，与服务端不一致时更新失败，必须设置"
+		if isVersionOptional(vf) {
+			pf.Comment = "期望的当前版本，与服务端不一致时更新失败，未设置时以读取到的版本为准"
+		}
+		all = append(all, fieldInfo{field: vf, pf: pf})
+	}
+	f.AddImport("google/protobuf/field_mask.proto")
+	all = append(all, fieldInfo{pf: &PbField{
+		Name:    "update_mask",
+		Type:    "google.protobuf.FieldMask",
+		Comment: "更新的字段路径，为空时更新请求中出现的字段",
+	}})
+	return e.buildRequestMessage(name, all)
+}
+
+func (e *Generator) buildRequestMessage(name string, all []fieldInfo) *PbMessage {
+	msg := &PbMessage{Name: name}
+	usedTags := make(map[int]bool)
+	for _, fi := range all {
+		if fi.pf.Tag > 0 {
+			usedTags[fi.pf.Tag] = true
+		}
+	}
+	e.assignProtoTags(msg, all, usedTags)
+	return msg
+}
@@ -0,0 +1,289 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	entgen "entgo.io/ent/entc/gen"
+)
+
+// Resource is the AIP resource name of a node annotated with WithResource,
+// e.g. users/{user}/posts/{post} for a Post under its author.
+type Resource struct {
+	Node      *entgen.Type
+	Type      string // resource type, e.g. blog.example.com/Post
+	NameField string // proto field holding the name in place of the ID
+	// Segments are the collection/{variable} pairs of the pattern, root
+	// parent first and the node itself last.
+	Segments []ResourceSegment
+}
+
+type ResourceSegment struct {
+	Node       *entgen.Type
+	Collection string // e.g. posts
+	Variable   string // e.g. post
+	// Parent is the unique inverse edge from Node to the node of the
+	// previous segment, nil for the root segment.
+	Parent *entgen.Edge
+}
+
+// Param returns the Go parameter of the segment in the format and parse
+// helpers, e.g. postID.
+func (s ResourceSegment) Param() string {
+	return camel(pascal(s.Variable)) + "ID"
+}
+
+func (r *Resource) Pattern() string {
+	parts := make([]string, 0, len(r.Segments))
+	for _, s := range r.Segments {
+		parts = append(parts, s.Collection+"/{"+s.Variable+"}")
+	}
+	return strings.Join(parts, "/")
+}
+
+// Wildcard returns the pattern with every variable replaced by *, used by
+// HTTP path bindings, e.g. users/*/posts/*.
+func (r *Resource) Wildcard() string {
+	parts := make([]string, 0, len(r.Segments))
+	for _, s := range r.Segments {
+		parts = append(parts, s.Collection+"/*")
+	}
+	return strings.Join(parts, "/")
+}
+
+// HTTPPath returns the HTTP path of a single resource, binding the name field
+// to the wildcard pattern, e.g. /v1/{resource_name=users/*}.
+func (r *Resource) HTTPPath(prefix string) string {
+	return fmt.Sprintf("%s/{%s=%s}", prefix, r.NameField, r.Wildcard())
+}
+
+// nameProtoField turns the proto field of the node ID into the name field,
+// keeping its tag.
+func (r *Resource) nameProtoField(pf *PbField) *PbField {
+	pf.Name, pf.Type, pf.Rules = r.NameField, "string", ""
+	pf.Comment = "资源名称，格式为 " + r.Pattern()
+	return pf
+}
+
+func (r *Resource) FormatFunc() string { return "Format" + r.Node.Name + "ResourceName" }
+
+func (r *Resource) ParseFunc() string { return "Parse" + r.Node.Name + "ResourceName" }
+
+func (r *Resource) NameGoField() string { return pascal(r.NameField) }
+
+func (r *Resource) Params() string {
+	return r.joinParams() + " string"
+}
+
+func (r *Resource) Results() string {
+	return r.joinParams() + " string, err error"
+}
+
+// Blanks returns the assignment targets taking the ID of the node from the
+// parse helper, discarding the parent IDs, e.g. "_, resourceID".
+func (r *Resource) Blanks(v string) string {
+	return strings.Repeat("_, ", len(r.Segments)-1) + v
+}
+
+func (r *Resource) joinParams() string {
+	params := make([]string, 0, len(r.Segments))
+	for _, s := range r.Segments {
+		params = append(params, s.Param())
+	}
+	return strings.Join(params, ", ")
+}
+
+// FromBiz returns the body of the function computing the resource name of the
+// biz entity b. Parent IDs are read through the parent edges as far as the biz
+// entity carries them, and default to the "-" wildcard otherwise.
+func (r *Resource) FromBiz() string {
+	var (
+		b    strings.Builder
+		last = len(r.Segments) - 1
+	)
+	parents := make([]string, 0, last)
+	for i := last - 1; i >= 0; i-- {
+		parents = append(parents, r.Segments[i].Param())
+	}
+	if len(parents) > 0 {
+		fmt.Fprintf(&b, "%s := %s\n", strings.Join(parents, ", "), strings.TrimSuffix(strings.Repeat(`"-", `, len(parents)), ", "))
+	}
+	var (
+		recv  = "b"
+		depth int
+	)
+	for i := last; i > 0; i-- {
+		e, param := r.Segments[i].Parent, r.Segments[i-1].Param()
+		if isBizIDOnly(e) {
+			fmt.Fprintf(&b, "if %s.%s != \"\" {\n%s = %s.%s\n}\n", recv, bizEdgeName(e), param, recv, bizEdgeName(e))
+			break
+		}
+		if !isBizPointer(e) {
+			break
+		}
+		id := bizFieldName(e.Type.ID)
+		fmt.Fprintf(&b, "if p := %s.%s; p != nil {\nif p.%s != \"\" {\n%s = p.%s\n}\n", recv, bizEdgeName(e), id, param, id)
+		recv = "p"
+		depth++
+	}
+	b.WriteString(strings.Repeat("}\n", depth))
+	args := make([]string, 0, len(r.Segments))
+	for _, s := range r.Segments[:last] {
+		args = append(args, s.Param())
+	}
+	args = append(args, "b."+bizFieldName(r.Node.ID))
+	fmt.Fprintf(&b, "return %s(%s)", r.FormatFunc(), strings.Join(args, ", "))
+	return b.String()
+}
+
+// buildResources resolves the resources declared by WithResource, checking
+// their types, IDs, parents and name fields.
+func buildResources(g *entgen.Graph, keep bool) (map[*entgen.Type]*Resource, error) {
+	res := make(map[*entgen.Type]*Resource)
+	visiting := make(map[*entgen.Type]bool)
+	var resolve func(n *entgen.Type) (*Resource, error)
+	resolve = func(n *entgen.Type) (*Resource, error) {
+		if r, ok := res[n]; ok {
+			return r, nil
+		}
+		if visiting[n] {
+			return nil, fmt.Errorf("lazyent: resource %s: cyclic parent", n.Name)
+		}
+		visiting[n] = true
+		defer delete(visiting, n)
+
+		a := getSchemaAnnotation(n)
+		if n.ID == nil {
+			return nil, fmt.Errorf("lazyent: resource %s: schema has no ID", n.Name)
+		}
+		if t := n.ID.Type.String(); t != "uuid.UUID" && t != "string" {
+			return nil, fmt.Errorf("lazyent: resource %s: ID must be a UUID or string, got %s", n.Name, t)
+		}
+		if a.Resource.Type == "" {
+			return nil, fmt.Errorf("lazyent: resource %s: type is required", n.Name)
+		}
+		r := &Resource{
+			Node:      n,
+			Type:      a.Resource.Type,
+			NameField: a.Resource.NameField,
+		}
+		if r.NameField == "" {
+			r.NameField = "name"
+		}
+		if err := checkResourceNameField(n, r.NameField, keep); err != nil {
+			return nil, err
+		}
+		parent, err := resourceParent(n, a.Resource.Parent)
+		if err != nil {
+			return nil, err
+		}
+		if parent != nil {
+			pr, err := resolve(parent.Type)
+			if err != nil {
+				return nil, err
+			}
+			r.Segments = append(r.Segments, pr.Segments...)
+		}
+		seg := ResourceSegment{
+			Node:       n,
+			Collection: a.Resource.Collection,
+			Variable:   entgen.Funcs["snake"].(func(string) string)(n.Name),
+			Parent:     parent,
+		}
+		if seg.Collection == "" {
+			seg.Collection = crudResource(n)
+		}
+		r.Segments = append(r.Segments, seg)
+		res[n] = r
+		return r, nil
+	}
+	for _, n := range g.Nodes {
+		if !isResource(n) {
+			continue
+		}
+		if _, err := resolve(n); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func isResource(n *entgen.Type) bool {
+	a := getSchemaAnnotation(n)
+	return a != nil && a.Resource != nil
+}
+
+// resourceParent returns the edge to the parent resource of a node: the edge
+// named by Resource.Parent, or else the first unique inverse edge to a
+// resource. It returns nil for root resources.
+func resourceParent(n *entgen.Type, name string) (*entgen.Edge, error) {
+	for _, e := range n.Edges {
+		if name != "" && e.Name != name {
+			continue
+		}
+		ok := e.IsInverse() && e.Unique && isResource(e.Type)
+		if ok {
+			return e, nil
+		}
+		if name != "" {
+			return nil, fmt.Errorf("lazyent: resource %s: parent edge %q must be a unique inverse edge to a resource", n.Name, name)
+		}
+	}
+	if name != "" {
+		return nil, fmt.Errorf("lazyent: resource %s: parent edge %q not found", n.Name, name)
+	}
+	return nil, nil
+}
+
+// checkResourceNameField reports an error if the name field of a resource
+// clashes with another proto field of its message.
+func checkResourceNameField(n *entgen.Type, name string, keep bool) error {
+	for _, f := range n.Fields {
+		if !isFieldProtoExclude(f, keep) && protoFieldName(f) == name {
+			return fmt.Errorf("lazyent: resource %s: name field %q clashes with field %s, set Resource.NameField", n.Name, name, f.Name)
+		}
+	}
+	for _, e := range n.Edges {
+		if !isProtoExclude(e) && protoEdgeName(e) == name {
+			return fmt.Errorf("lazyent: resource %s: name field %q clashes with edge %s, set Resource.NameField", n.Name, name, e.Name)
+		}
+	}
+	return nil
+}
+
+func (r *Resource) Join() string {
+	parts := make([]string, 0, 2*len(r.Segments))
+	for i, s := range r.Segments {
+		prefix := s.Collection + "/"
+		if i > 0 {
+			prefix = "/" + prefix
+		}
+		parts = append(parts, fmt.Sprintf("%q", prefix), s.Param())
+	}
+	return strings.Join(parts, " + ")
+}
+
+// Invalid returns the condition under which the split resource name parts do
+// not match the pattern.
+func (r *Resource) Invalid(parts string) string {
+	conds := []string{fmt.Sprintf("len(%s) != %d", parts, 2*len(r.Segments))}
+	for i, s := range r.Segments {
+		conds = append(conds,
+			fmt.Sprintf("%s[%d] != %q", parts, 2*i, s.Collection),
+			fmt.Sprintf("%s[%d] == \"\"", parts, 2*i+1),
+		)
+	}
+	return strings.Join(conds, " || ")
+}
+
+func (r *Resource) Values(parts string) string {
+	vals := make([]string, 0, len(r.Segments))
+	for i := range r.Segments {
+		vals = append(vals, fmt.Sprintf("%s[%d]", parts, 2*i+1))
+	}
+	return strings.Join(vals, ", ")
+}
+
+func (r *Resource) Zeros() string {
+	return strings.TrimSuffix(strings.Repeat(`"", `, len(r.Segments)), ", ")
+}
@@ -0,0 +1,99 @@
+package gen
+
+import (
+	"fmt"
+
+	entgen "entgo.io/ent/entc/gen"
+	"github.com/Cromemadnd/lazyent/internal/types"
+)
+
+func isSoftDeleteField(f *entgen.Field) bool {
+	a := getFieldAnnotation(f)
+	return a != nil && a.SoftDelete
+}
+
+// softDeleteField returns the soft-delete timestamp of a node, e.g. the
+// deleted_at field of a mixin, or nil if it has none.
+func softDeleteField(n *entgen.Type) *entgen.Field {
+	if n.ID == nil {
+		return nil
+	}
+	for _, f := range n.Fields {
+		if isSoftDeleteField(f) {
+			return f
+		}
+	}
+	return nil
+}
+
+func hasRestoreRepo(n *entgen.Type) bool {
+	return softDeleteField(n) != nil && hasRepoMethod(n, types.RepoDelete)
+}
+
+func hasRestoreRPC(n *entgen.Type) bool {
+	return softDeleteField(n) != nil && hasCRUDMethod(n, types.CRUDDelete)
+}
+
+func restoreRPCName(n *entgen.Type) string {
+	return "Restore" + n.Name
+}
+
+// checkSoftDeleteFields reports misplaced WithSoftDelete annotations: a node
+// with an ID has at most one soft-delete field, an Optional time field.
+func checkSoftDeleteFields(g *entgen.Graph) error {
+	for _, n := range g.Nodes {
+		var found bool
+		for _, f := range n.Fields {
+			if !isSoftDeleteField(f) {
+				continue
+			}
+			if found {
+				return fmt.Errorf("lazyent: %s: more than one soft-delete field", n.Name)
+			}
+			found = true
+			if n.ID == nil {
+				return fmt.Errorf("lazyent: %s.%s: soft delete requires an ID", n.Name, f.Name)
+			}
+			if f.Type.String() != "time.Time" || !f.Optional {
+				return fmt.Errorf("lazyent: %s.%s: soft-delete field must be an Optional time field", n.Name, f.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// buildRestoreRPC appends the RestoreX RPC of a soft-deleting node and its
+// request/reply messages, bound to POST <resource>:restore.
+func (e *Generator) buildRestoreRPC(n *entgen.Type, svc *PbService, f *PbFile, resource string, idField func() *PbField) {
+	if !hasRestoreRPC(n) {
+		return
+	}
+	name := restoreRPCName(n)
+	rpc := &PbMethod{
+		Name:     name,
+		Request:  name + "Request",
+		Reply:    name + "Reply",
+		Comment:  fmt.Sprintf("%s 恢复已软删除的 %s", name, n.Name),
+		HTTPVerb: "post",
+		HTTPPath: resource + ":restore",
+		HTTPBody: "*",
+	}
+	svc.Methods = append(svc.Methods, rpc)
+	f.Elements = append(f.Elements,
+		PbElement{Message: &PbMessage{Name: rpc.Request, Fields: []*PbField{idField()}}},
+		PbElement{Message: &PbMessage{Name: rpc.Reply, Fields: []*PbField{{Name: entgen.Funcs["snake"].(func(string) string)(n.Name), Type: n.Name, Tag: 1}}}},
+	)
+}
+
+// edgeSoftDeletePredicate returns the predicate on the query of e.Type keeping
+// the targets of e that are not soft deleted, e.g. user.DeletedAtIsNil(), or
+// membership.HasUserWith(user.DeletedAtIsNil()) for edges to an edge schema.
+// It returns "" if the targets have no soft-delete field.
+func edgeSoftDeletePredicate(e *entgen.Edge) string {
+	return edgeTargetPredicate(e, func(t *entgen.Type) string {
+		if f := softDeleteField(t); f != nil {
+			return fmt.Sprintf("%s.%sIsNil()", t.Package(), f.StructField())
+		}
+		return ""
+	})
+}
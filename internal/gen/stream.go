@@ -0,0 +1,46 @@
+package gen
+
+import (
+	"fmt"
+
+	entgen "entgo.io/ent/entc/gen"
+)
+
+func hasStream(n *entgen.Type) bool {
+	a := getSchemaAnnotation(n)
+	return n.ID != nil && a != nil && a.Stream
+}
+
+func hasStreamRPC(n *entgen.Type) bool {
+	return hasStream(n) && hasCRUDService(n)
+}
+
+func hasStreamRepo(n *entgen.Type) bool {
+	return hasStream(n) && hasRepo(n)
+}
+
+func streamRPCName(n *entgen.Type) string {
+	return "Stream" + pascal(crudResource(n))
+}
+
+// buildStreamRPC appends the server-streaming export RPC of a node and its
+// request message. Streams have no HTTP binding.
+func (e *Generator) buildStreamRPC(n *entgen.Type, svc *PbService, f *PbFile) {
+	if !hasStreamRPC(n) {
+		return
+	}
+	e.buildListMessages(n, f)
+	rpc := &PbMethod{
+		Name:         streamRPCName(n),
+		Request:      streamRPCName(n) + "Request",
+		Reply:        n.Name,
+		Comment:      fmt.Sprintf("%s 按 ID 顺序分批读取并流式返回 %s", streamRPCName(n), n.Name),
+		ServerStream: true,
+	}
+	req := &PbMessage{Name: rpc.Request, Fields: []*PbField{
+		{Name: "filter", Type: n.Name + "Filter", Tag: 1},
+		{Name: "batch_size", Type: "int32", Tag: 2, Comment: "每批读取数量，为 0 时使用默认值"},
+	}}
+	svc.Methods = append(svc.Methods, rpc)
+	f.Elements = append(f.Elements, PbElement{Message: req})
+}
@@ -0,0 +1,220 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	entgen "entgo.io/ent/entc/gen"
+	"github.com/Cromemadnd/lazyent/internal/types"
+)
+
+// SubResource is an operation on the targets of an edge, selected by its
+// EdgeMethods annotation, e.g. ListUserPosts or AddGroupUsers.
+type SubResource struct {
+	Edge   *entgen.Edge
+	Method types.EdgeMethod
+}
+
+// edgeMethods returns the sub-resource methods selected by the edge
+// annotation, in canonical order. Only non-unique edges between nodes with
+// IDs have sub-resources, and only M2M edges can be added to or removed from.
+func edgeMethods(e *entgen.Edge) []types.EdgeMethod {
+	if e.Unique || isThroughEdge(e) || edgeNode(e).ID == nil || e.Type.ID == nil {
+		return nil
+	}
+	a := getAnnotation(e)
+	if a == nil || len(a.EdgeMethods) == 0 {
+		return nil
+	}
+	var res []types.EdgeMethod
+	for _, m := range types.AllEdgeMethods {
+		if m != types.EdgeList && !e.M2M() {
+			continue
+		}
+		for _, want := range a.EdgeMethods {
+			if m == want {
+				res = append(res, m)
+				break
+			}
+		}
+	}
+	return res
+}
+
+func hasEdgeMethod(e *entgen.Edge, m types.EdgeMethod) bool {
+	for _, v := range edgeMethods(e) {
+		if v == m {
+			return true
+		}
+	}
+	return false
+}
+
+// subResources returns the sub-resource operations of a node, by edge then
+// method.
+func subResources(n *entgen.Type) []SubResource {
+	var res []SubResource
+	for _, e := range n.Edges {
+		for _, m := range edgeMethods(e) {
+			res = append(res, SubResource{Edge: e, Method: m})
+		}
+	}
+	return res
+}
+
+func (s SubResource) IsList() bool { return s.Method == types.EdgeList }
+
+func (s SubResource) IsAdd() bool { return s.Method == types.EdgeAdd }
+
+// TargetPredicates returns the predicates matching the targets tids an Add
+// method may link: existing, not soft-deleted and in the tenant of ctx.
+func (s SubResource) TargetPredicates() string {
+	t := s.Edge.Type
+	preds := []string{t.Package() + ".IDIn(tids...)"}
+	if f := softDeleteField(t); f != nil {
+		preds = append(preds, t.Package()+"."+f.StructField()+"IsNil()")
+	}
+	if f := tenantField(t); f != nil {
+		preds = append(preds, t.Package()+"."+f.StructField()+"(tenant)")
+	}
+	return strings.Join(preds, ", ")
+}
+
+// hasAddSubResources reports whether any node of g has an Add sub-resource.
+func hasAddSubResources(g *entgen.Graph) bool {
+	for _, n := range g.Nodes {
+		for _, s := range subResources(n) {
+			if s.IsAdd() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s SubResource) Name() string {
+	return pascal(string(s.Method)) + edgeNode(s.Edge).Name + s.Edge.StructField()
+}
+
+func (s SubResource) UsecaseName() string {
+	return pascal(string(s.Method)) + s.Edge.StructField()
+}
+
+func (s SubResource) Field() string {
+	return entgen.Funcs["snake"].(func(string) string)(s.Edge.Name)
+}
+
+func (s SubResource) IDsField() string {
+	singular := entgen.Funcs["singular"].(func(string) string)(s.Edge.Name)
+	return entgen.Funcs["snake"].(func(string) string)(singular) + "_ids"
+}
+
+func (s SubResource) EntSetter() string {
+	if s.Method == types.EdgeRemove {
+		return s.Edge.MutationRemove()
+	}
+	return s.Edge.MutationAdd()
+}
+
+// CreatesThrough reports whether targets are added by creating the edge
+// schema entities in bulk. ent fills the defaults of an edge schema once per
+// mutation, so adding several targets with AddXIDs would share e.g. their
+// generated UUIDs.
+func (s SubResource) CreatesThrough() bool {
+	return s.Method == types.EdgeAdd && s.Edge.Through != nil
+}
+
+func (s SubResource) NodeSetter() string {
+	return s.throughSetter(!s.Edge.IsInverse())
+}
+
+func (s SubResource) TargetSetter() string {
+	return s.throughSetter(s.Edge.IsInverse())
+}
+
+func (s SubResource) throughSetter(owner bool) string {
+	col := s.Edge.Rel.Columns[1]
+	if owner {
+		col = s.Edge.Rel.Columns[0]
+	}
+	for _, f := range s.Edge.Through.Fields {
+		if f.StorageKey() == col {
+			return f.MutationSet()
+		}
+	}
+	return ""
+}
+
+func (s SubResource) HTTPPath(resource string) string {
+	path := resource + "/" + s.Field()
+	if !s.IsList() {
+		path += ":" + string(s.Method)
+	}
+	return path
+}
+
+// edgeListed reports whether the node is listed by an edge sub-resource, and
+// whether one of those lists is served by an RPC.
+func (e *Generator) edgeListed(n *entgen.Type) (listed, rpc bool) {
+	for _, o := range e.graph.Nodes {
+		for _, ed := range o.Edges {
+			if ed.Type != n || !hasEdgeMethod(ed, types.EdgeList) {
+				continue
+			}
+			listed = listed || hasCRUDService(o) || hasRepo(o)
+			rpc = rpc || hasCRUDService(o)
+		}
+	}
+	return listed, rpc
+}
+
+// buildSubResources appends the sub-resource RPCs of a node and their
+// request/reply messages to its service.
+func (e *Generator) buildSubResources(n *entgen.Type, svc *PbService, f *PbFile, resource string, idField func() *PbField) {
+	for _, s := range subResources(n) {
+		target := s.Edge.Type
+		rpc := &PbMethod{
+			Name:     s.Name(),
+			Request:  s.Name() + "Request",
+			Reply:    s.Name() + "Reply",
+			HTTPPath: s.HTTPPath(resource),
+		}
+		req := &PbMessage{Name: rpc.Request, Fields: []*PbField{idField()}}
+		reply := &PbMessage{Name: rpc.Reply}
+		if s.IsList() {
+			rpc.Comment = fmt.Sprintf("%s 分页列出 %s 经由 %s 关联的 %s", rpc.Name, n.Name, s.Edge.Name, target.Name)
+			rpc.HTTPVerb = "get"
+			e.buildListMessages(target, f)
+			req.Fields = append(req.Fields,
+				&PbField{Name: "page_size", Type: "int32", Tag: 2, Comment: "每页数量，为 0 时使用默认值"},
+				&PbField{Name: "page_token", Type: "string", Tag: 3, Comment: "上一页返回的 next_page_token，为空时从第一页开始"},
+				&PbField{Name: "filter", Type: target.Name + "Filter", Tag: 4},
+				&PbField{Name: "order_by", Type: orderEnumName(target), Tag: 5, Comment: "排序字段，未指定时按 ID 排序"},
+				&PbField{Name: "desc", Type: "bool", Tag: 6, Comment: "是否降序"},
+			)
+			if softDeleteField(target) != nil {
+				req.Fields = append(req.Fields, &PbField{Name: "include_deleted", Type: "bool", Tag: 7, Comment: "是否包含已软删除的 " + target.Name})
+			}
+			reply.Fields = []*PbField{
+				{Name: s.Field(), Type: target.Name, Tag: 1, Repeated: true},
+				{Name: "next_page_token", Type: "string", Tag: 2, Comment: "下一页的 page_token，为空时没有更多数据"},
+			}
+		} else {
+			verb := "添加"
+			if s.Method == types.EdgeRemove {
+				verb = "移除"
+			}
+			rpc.Comment = fmt.Sprintf("%s 为 %s %s %s 关联的 %s", rpc.Name, n.Name, verb, s.Edge.Name, target.Name)
+			rpc.HTTPVerb, rpc.HTTPBody = "post", "*"
+			req.Fields = append(req.Fields, &PbField{
+				Name:     s.IDsField(),
+				Type:     e.resolveProtoType(target.ID, target.Name, f),
+				Tag:      2,
+				Repeated: true,
+				Rules:    repeatedIDRules(target.ID),
+			})
+		}
+		svc.Methods = append(svc.Methods, rpc)
+		f.Elements = append(f.Elements, PbElement{Message: req}, PbElement{Message: reply})
+	}
+}
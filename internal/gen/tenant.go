@@ -0,0 +1,141 @@
+package gen
+
+import (
+	"fmt"
+
+	entgen "entgo.io/ent/entc/gen"
+)
+
+func isTenantField(f *entgen.Field) bool {
+	a := getFieldAnnotation(f)
+	return a != nil && a.Tenant
+}
+
+func tenantField(n *entgen.Type) *entgen.Field {
+	if n.ID == nil {
+		return nil
+	}
+	for _, f := range n.Fields {
+		if isTenantField(f) {
+			return f
+		}
+	}
+	return nil
+}
+
+func tenantFields(g *entgen.Graph) []*entgen.Field {
+	var res []*entgen.Field
+	for _, n := range g.Nodes {
+		if f := tenantField(n); f != nil {
+			res = append(res, f)
+		}
+	}
+	return res
+}
+
+// tenantEntType returns the ent type shared by the tenant fields of the graph,
+// e.g. string or uuid.UUID, or "" if there are none.
+func tenantEntType(g *entgen.Graph) string {
+	if fs := tenantFields(g); len(fs) > 0 {
+		return fs[0].Type.String()
+	}
+	return ""
+}
+
+func tenantBizType(g *entgen.Graph) string {
+	if t := tenantEntType(g); t != "uuid.UUID" {
+		return t
+	}
+	return "string"
+}
+
+// tenantSetup generates the statements reading the tenant ID of ctx into
+// tenant, returning ret followed by the error on failure. It returns "" for
+// nodes without a tenant field.
+func tenantSetup(n *entgen.Type, ret string) string {
+	if tenantField(n) == nil {
+		return ""
+	}
+	return fmt.Sprintf("tenant, err := tenantID(ctx, r.tenant)\nif err != nil {\n\treturn %serr\n}", ret)
+}
+
+// edgeTenantPredicate returns the predicate on the query of e.Type keeping the
+// targets of e in the tenant of the EdgeScope scope, e.g.
+// group.TenantID(scope.Tenant), or membership.HasGroupWith(...) for edges to
+// an edge schema. It returns "" if the targets have no tenant field.
+func edgeTenantPredicate(e *entgen.Edge) string {
+	return edgeTargetPredicate(e, func(t *entgen.Type) string {
+		if f := tenantField(t); f != nil {
+			return fmt.Sprintf("%s.%s(scope.Tenant)", t.Package(), f.StructField())
+		}
+		return ""
+	})
+}
+
+// edgeScopeTenant reports whether the EdgeScope of a query loading nodes of n
+// needs the tenant of ctx: the eager loads or edge counts of n read nodes of a
+// tenant type, or the query traverses an edge to n and n has a tenant field.
+func edgeScopeTenant(n *entgen.Type, traversal bool) bool {
+	if traversal && tenantField(n) != nil {
+		return true
+	}
+	for _, e := range n.Edges {
+		if eagerLoad(e) != "" && edgeTenantPredicate(e) != "" {
+			return true
+		}
+		if hasEdgeCount(e) && tenantField(e.Type) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// edgeScopeSetup generates the statements declaring the EdgeScope scope of a
+// query loading nodes of n, reading the tenant of ctx if edgeScopeTenant
+// reports so and returning ret followed by the error on failure. Without a
+// tenant in ctx, eager loads and counts skip tenant nodes, but a traversal to
+// a tenant node fails with ErrNoTenant.
+func edgeScopeSetup(n *entgen.Type, traversal bool, ret string) string {
+	if !edgeScopeTenant(n, traversal) {
+		return "var scope EdgeScope"
+	}
+	s := fmt.Sprintf("scope, err := edgeScope(ctx, r.tenant)\nif err != nil {\n\treturn %serr\n}", ret)
+	if traversal && tenantField(n) != nil {
+		s += fmt.Sprintf("\nif !scope.HasTenant {\n\treturn %sbiz.ErrNoTenant\n}", ret)
+	}
+	return s
+}
+
+// checkTenantFields reports misplaced WithTenant annotations: a node with an
+// ID has at most one tenant field, and all tenant fields of the graph share a
+// string, int or UUID type without a custom biz type.
+func checkTenantFields(g *entgen.Graph, keep bool) error {
+	var typ string
+	for _, n := range g.Nodes {
+		var found bool
+		for _, f := range n.Fields {
+			if !isTenantField(f) {
+				continue
+			}
+			if found {
+				return fmt.Errorf("lazyent: %s: more than one tenant field", n.Name)
+			}
+			found = true
+			if n.ID == nil {
+				return fmt.Errorf("lazyent: %s.%s: tenant field requires an ID", n.Name, f.Name)
+			}
+			switch t := f.Type.String(); {
+			case t != "string" && t != "uuid.UUID" && t != "int" && t != "int64":
+				return fmt.Errorf("lazyent: %s.%s: tenant field must be a string, int, int64 or UUID, got %s", n.Name, f.Name, t)
+			case typ != "" && t != typ:
+				return fmt.Errorf("lazyent: %s.%s: tenant fields must share one type, got %s and %s", n.Name, f.Name, typ, t)
+			default:
+				typ = t
+			}
+			if f.Nillable || explicitBizType(f) != "" || isFieldBizExclude(f, keep) {
+				return fmt.Errorf("lazyent: %s.%s: tenant field must be non-nillable, kept in biz and without a custom biz type", n.Name, f.Name)
+			}
+		}
+	}
+	return nil
+}
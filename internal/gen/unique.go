@@ -0,0 +1,185 @@
+package gen
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+
+	entgen "entgo.io/ent/entc/gen"
+)
+
+// UniqueKey is a lookup key of a node: a Unique field, or the fields of a
+// unique index declared in Indexes().
+type UniqueKey struct {
+	Node   *entgen.Type
+	Fields []*entgen.Field
+}
+
+// reservedParams are identifiers used by the generated lookup methods that
+// key parameters must not shadow.
+var reservedParams = map[string]bool{
+	"ctx": true, "r": true, "s": true, "req": true, "err": true, "b": true,
+	"biz": true, "ent": true, "pb": true, "context": true, "fmt": true,
+	"errors": true, "time": true, "uuid": true, "strconv": true, "tenant": true,
+}
+
+// uniqueKeys returns the lookup keys of a node whose fields are all kept in
+// biz: its Unique fields first, then its unique indexes, in schema order.
+// Indexes on edge columns and JSON fields are skipped. Tenant fields are left
+// out of the keys as the repository scopes every lookup to the tenant.
+func uniqueKeys(n *entgen.Type, keepSensitive bool) []UniqueKey {
+	lookup := func(f *entgen.Field) bool {
+		return f != nil && !f.IsJSON() && !isFieldBizExclude(f, keepSensitive)
+	}
+	var res []UniqueKey
+	for _, f := range n.Fields {
+		if f.Unique && lookup(f) && !isTenantField(f) {
+			res = append(res, UniqueKey{Node: n, Fields: []*entgen.Field{f}})
+		}
+	}
+	byColumn := make(map[string]*entgen.Field, len(n.Fields))
+	for _, f := range n.Fields {
+		byColumn[f.StorageKey()] = f
+	}
+indexes:
+	for _, idx := range n.Indexes {
+		if !idx.Unique {
+			continue
+		}
+		k := UniqueKey{Node: n}
+		for _, c := range idx.Columns {
+			f := byColumn[c]
+			if !lookup(f) {
+				continue indexes
+			}
+			if !isTenantField(f) {
+				k.Fields = append(k.Fields, f)
+			}
+		}
+		if len(k.Fields) == 0 || len(k.Fields) == 1 && k.Fields[0].Unique {
+			continue
+		}
+		res = append(res, k)
+	}
+	return res
+}
+
+// protoUniqueKeys returns the lookup keys whose fields are all visible in
+// proto, each served by a GetXByY RPC.
+func protoUniqueKeys(n *entgen.Type, keepSensitive bool) []UniqueKey {
+	var res []UniqueKey
+keys:
+	for _, k := range uniqueKeys(n, keepSensitive) {
+		for _, f := range k.Fields {
+			if isFieldProtoExclude(f, keepSensitive) {
+				continue keys
+			}
+		}
+		res = append(res, k)
+	}
+	return res
+}
+
+func (k UniqueKey) Name() string {
+	names := make([]string, 0, len(k.Fields))
+	for _, f := range k.Fields {
+		names = append(names, bizFieldName(f))
+	}
+	return strings.Join(names, "And")
+}
+
+func (k UniqueKey) Columns() string {
+	names := make([]string, 0, len(k.Fields))
+	for _, f := range k.Fields {
+		names = append(names, f.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func (k UniqueKey) FinderName() string {
+	return "FindBy" + k.Name()
+}
+
+func (k UniqueKey) UsecaseName() string {
+	return "GetBy" + k.Name()
+}
+
+func (k UniqueKey) RPCName() string {
+	return "Get" + k.Node.Name + "By" + k.Name()
+}
+
+func (k UniqueKey) param(f *entgen.Field) string {
+	p := camel(bizFieldName(f))
+	if token.IsKeyword(p) || reservedParams[p] || p == k.Node.Package() {
+		p += "Value"
+	}
+	return p
+}
+
+func (k UniqueKey) Params(qual string) string {
+	params := make([]string, 0, len(k.Fields))
+	for _, f := range k.Fields {
+		t := bizValueType(f, k.Node.Name)
+		if f.IsEnum() && !isExternalEnum(f) {
+			t = qual + t
+		}
+		params = append(params, k.param(f)+" "+t)
+	}
+	return strings.Join(params, ", ")
+}
+
+func (k UniqueKey) Args() string {
+	args := make([]string, 0, len(k.Fields))
+	for _, f := range k.Fields {
+		args = append(args, k.param(f))
+	}
+	return strings.Join(args, ", ")
+}
+
+// FromProto returns the arguments of the usecase lookup converted from the
+// GetXByYRequest req.
+func (k UniqueKey) FromProto() string {
+	args := make([]string, 0, len(k.Fields))
+	for _, f := range k.Fields {
+		args = append(args, convertFromProtoExpr(f, k.Node.Name, fmt.Sprintf("req.Get%s()", protoGoName(f))))
+	}
+	return strings.Join(args, ", ")
+}
+
+// Setup generates the statements parsing the parameters that do not convert
+// to their ent types without error.
+func (k UniqueKey) Setup() string {
+	var stmts []string
+	for _, f := range k.Fields {
+		if s := parseFieldFromBiz(f, k.Node.Name, k.param(f), k.param(f)+"Val", "nil, "); s != "" {
+			stmts = append(stmts, s)
+		}
+	}
+	return strings.Join(stmts, "\n")
+}
+
+func (k UniqueKey) Predicates() string {
+	preds := make([]string, 0, len(k.Fields))
+	for _, f := range k.Fields {
+		arg := convertBizToEntValue(f, k.Node.Name, k.param(f))
+		if parseFieldFromBiz(f, "", "", "", "") != "" {
+			arg = k.param(f) + "Val"
+		}
+		preds = append(preds, fmt.Sprintf("%s.%sEQ(%s)", k.Node.Package(), f.StructField(), arg))
+	}
+	return strings.Join(preds, ", ")
+}
+
+// HTTPPath returns the route of the lookup RPC below the collection. Single
+// field keys are bound in the path, composite keys are query parameters.
+func (k UniqueKey) HTTPPath(collection string) string {
+	names := make([]string, 0, len(k.Fields))
+	for _, f := range k.Fields {
+		names = append(names, protoFieldName(f))
+	}
+	path := collection + "/by_" + strings.Join(names, "_and_")
+	if len(names) == 1 {
+		path += "/{" + names[0] + "}"
+	}
+	return path
+}
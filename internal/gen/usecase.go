@@ -0,0 +1,21 @@
+package gen
+
+import (
+	entgen "entgo.io/ent/entc/gen"
+	"github.com/Cromemadnd/lazyent/internal/types"
+)
+
+func usecaseFileName(n *entgen.Type) string {
+	return entgen.Funcs["snake"].(func(string) string)(n.Name) + "_usecase.go"
+}
+
+// usecaseRepoMethods are the repository methods a generated usecase needs to
+// serve each CRUD method, added to those of WithRepo.
+var usecaseRepoMethods = map[types.CRUDMethod][]types.RepoMethod{
+	types.CRUDCreate:      {types.RepoSave},
+	types.CRUDGet:         {types.RepoFindByID},
+	types.CRUDGetByUnique: {types.RepoFindByUnique},
+	types.CRUDUpdate:      {types.RepoUpdate, types.RepoFindByID},
+	types.CRUDDelete:      {types.RepoDelete},
+	types.CRUDList:        {types.RepoList},
+}
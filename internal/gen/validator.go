@@ -5,24 +5,22 @@ import (
 	"strings"
 
 	entgen "entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
 	types "github.com/Cromemadnd/lazyent/internal/types"
 )
 
-func getValidateRules(f *entgen.Field, nodeName string, validatorType types.ProtoValidator) string {
+// getValidateRules renders the proto validation rules of a field of n. n may
+// be nil, in which case schema and global defaults are not applied.
+func getValidateRules(f *entgen.Field, n *entgen.Type, validatorType types.ProtoValidator) string {
 	if validatorType == types.ProtoValidatorNoValidator {
 		return ""
 	}
 
 	// Get Annotation
 	a := getFieldAnnotation(f)
-	var rules *types.ValidationRules
 
-	// 1. Initialize from Struct Annotation if present
-	if a != nil && a.Validation != nil {
-		rules = a.Validation
-	} else {
-		rules = &types.ValidationRules{}
-	}
+	// 1. Initialize from Struct Annotations (field > schema > global)
+	rules := fieldValidation(f, n)
 
 	// 2. Apply Defaults
 	// Enum
@@ -82,6 +80,46 @@ func getValidateRules(f *entgen.Field, nodeName string, validatorType types.Prot
 	return ""
 }
 
+// fieldValidation returns a copy of the structured validation rules of a
+// field. The field's own rules take precedence; rules declared on the
+// Annotations() of its schema or globally are defaults, applied by kind to the
+// fields they fit, e.g. StringRules to the string fields only.
+func fieldValidation(f *entgen.Field, n *entgen.Type) *types.ValidationRules {
+	rules := &types.ValidationRules{}
+	layers := []*types.Annotation{getFieldAnnotation(f)}
+	// A legacy ProtoValidation string on the field replaces the defaults.
+	if a := layers[0]; a != nil && a.Validation == nil && a.ProtoValidation != "" {
+		return rules
+	}
+	if n != nil {
+		layers = append(layers, getSchemaAnnotation(n), getGlobalAnnotation(n))
+	}
+	for i, a := range layers {
+		if a == nil || a.Validation == nil {
+			continue
+		}
+		v, own := a.Validation, i == 0
+		slice, enum := isSlice(f), f.IsEnum()
+		if v.String != nil && rules.String == nil && (own || f.Type.Type == field.TypeString) {
+			c := *v.String
+			rules.String = &c
+		}
+		if v.Number != nil && rules.Number == nil && (own || f.Type.Numeric()) {
+			c := *v.Number
+			rules.Number = &c
+		}
+		if v.Repeated != nil && rules.Repeated == nil && (own || slice) {
+			c := *v.Repeated
+			rules.Repeated = &c
+		}
+		if v.Enum != nil && rules.Enum == nil && (own || enum) {
+			c := *v.Enum
+			rules.Enum = &c
+		}
+	}
+	return rules
+}
+
 func isValidationEmpty(v *types.ValidationRules) bool {
 	if v == nil {
 		return true
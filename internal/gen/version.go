@@ -0,0 +1,65 @@
+package gen
+
+import (
+	"fmt"
+
+	entgen "entgo.io/ent/entc/gen"
+)
+
+func isVersionField(f *entgen.Field) bool {
+	a := getFieldAnnotation(f)
+	return a != nil && a.VersionField
+}
+
+// isVersionOptional reports whether updates may omit the expected version of
+// the version field f, falling back to last-write-wins.
+func isVersionOptional(f *entgen.Field) bool {
+	a := getFieldAnnotation(f)
+	return a != nil && a.VersionOptional
+}
+
+// versionField returns the optimistic concurrency version field of a node, or
+// nil if it has none.
+func versionField(n *entgen.Type) *entgen.Field {
+	for _, f := range n.Fields {
+		if isVersionField(f) {
+			return f
+		}
+	}
+	return nil
+}
+
+func hasVersionFields(g *entgen.Graph) bool {
+	for _, n := range g.Nodes {
+		if versionField(n) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// checkVersionFields reports misplaced WithVersionField annotations: a node
+// has at most one version field, a non-nillable integer exposed in proto.
+func checkVersionFields(g *entgen.Graph, keep bool) error {
+	for _, n := range g.Nodes {
+		var found bool
+		for _, f := range n.Fields {
+			if !isVersionField(f) {
+				continue
+			}
+			if found {
+				return fmt.Errorf("lazyent: %s: more than one version field", n.Name)
+			}
+			found = true
+			switch f.Type.String() {
+			case "int", "int32", "int64":
+			default:
+				return fmt.Errorf("lazyent: %s.%s: version field must be an int, int32 or int64, got %s", n.Name, f.Name, f.Type)
+			}
+			if f.Nillable || isFieldBizExclude(f, keep) || isFieldProtoExclude(f, keep) {
+				return fmt.Errorf("lazyent: %s.%s: version field must be non-nillable and kept in biz and proto", n.Name, f.Name)
+			}
+		}
+	}
+	return nil
+}
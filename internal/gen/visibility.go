@@ -0,0 +1,189 @@
+package gen
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	entgen "entgo.io/ent/entc/gen"
+)
+
+// Visibility describes how the proto message of a node is redacted by its
+// generated BizXToProtoFor mapper: fields and edges annotated with
+// WithVisibleTo are cleared for other roles, and message edges leading to
+// redacted nodes are redacted recursively.
+type Visibility struct {
+	Restricted []RestrictedField
+	Nested     []NestedRedaction
+}
+
+type RestrictedField struct {
+	GoFields []string // Proto Go field names, e.g. GroupIds and GroupCount
+	Roles    []string // Roles allowed to see the fields
+}
+
+func (r RestrictedField) Args() string {
+	args := make([]string, len(r.Roles))
+	for i, role := range r.Roles {
+		args[i] = strconv.Quote(role)
+	}
+	return strings.Join(args, ", ")
+}
+
+type NestedRedaction struct {
+	GoField  string // Proto Go field name of the edge, e.g. Author
+	Target   string // Redacted node, e.g. User
+	Repeated bool
+	Assoc    string // Proto Go field of the target in association items, e.g. User
+}
+
+func (r NestedRedaction) Getter(v string) string {
+	if r.Assoc != "" {
+		return v + ".Get" + r.Assoc + "()"
+	}
+	return v
+}
+
+// bizToProto returns the call mapping the biz entity v of n to proto for the
+// caller role: BizXToProtoFor for nodes with restricted fields, BizXToProto
+// otherwise.
+func (e *Generator) bizToProto(n *entgen.Type, v string) string {
+	if e.visibility[n] != nil {
+		return "Biz" + n.Name + "ToProtoFor(role, " + v + ")"
+	}
+	return "Biz" + n.Name + "ToProto(" + v + ")"
+}
+
+// serviceRedacts reports whether replies of the CRUD service of n carry
+// redacted nodes, so that its XServiceBase needs a RoleResolver.
+func (e *Generator) serviceRedacts(n *entgen.Type) bool {
+	if e.visibility[n] != nil {
+		return true
+	}
+	for _, s := range subResources(n) {
+		if s.IsList() && e.visibility[s.Edge.Type] != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// restrict adds proto fields visible to roles, sharing the check of the
+// previous fields when their roles are the same.
+func (v *Visibility) restrict(roles []string, fields ...string) {
+	if len(fields) == 0 {
+		return
+	}
+	if n := len(v.Restricted); n > 0 && slices.Equal(v.Restricted[n-1].Roles, roles) {
+		v.Restricted[n-1].GoFields = append(v.Restricted[n-1].GoFields, fields...)
+		return
+	}
+	v.Restricted = append(v.Restricted, RestrictedField{GoFields: fields, Roles: roles})
+}
+
+func fieldVisibleTo(f *entgen.Field) []string {
+	if a := getFieldAnnotation(f); a != nil {
+		return a.VisibleTo
+	}
+	return nil
+}
+
+func edgeVisibleTo(e *entgen.Edge) []string {
+	if a := getAnnotation(e); a != nil {
+		return a.VisibleTo
+	}
+	return nil
+}
+
+// edgeProtoGoFields returns the proto Go fields an edge is mapped to by
+// BizXToProto, including its count field.
+func edgeProtoGoFields(n *entgen.Type, e *entgen.Edge) []string {
+	var res []string
+	switch {
+	case isProtoExclude(e):
+	case isProtoMessage(e):
+		res = append(res, pascal(e.Name))
+	case !e.Unique:
+		res = append(res, protoStructField(e))
+	case edgeHasFK(e) && !hasField(n.Fields, edgeField(e)):
+		res = append(res, protoStructField(e))
+	}
+	if hasEdgeCount(e) {
+		res = append(res, pascal(edgeCountProtoName(e)))
+	}
+	return res
+}
+
+// messageEdgeTarget returns the node embedded as a message by an edge and the
+// proto Go field holding it in association items, or nil if the edge carries
+// no messages.
+func messageEdgeTarget(e *entgen.Edge, keep bool) (*entgen.Type, string) {
+	if isProtoExclude(e) || !isProtoMessage(e) {
+		return nil, ""
+	}
+	if !isThroughEdge(e) {
+		return e.Type, ""
+	}
+	a, ok := newAssocDef(e, keep)
+	if !ok || !a.ProtoMessage() {
+		return nil, ""
+	}
+	return a.Target.Type, pascal(a.ProtoField())
+}
+
+// buildVisibility resolves the redaction of every node affected by
+// WithVisibleTo, either directly or through message edges.
+func buildVisibility(g *entgen.Graph, keep bool) map[*entgen.Type]*Visibility {
+	res := make(map[*entgen.Type]*Visibility)
+	for _, n := range g.Nodes {
+		v := &Visibility{}
+		for _, f := range n.Fields {
+			if roles := fieldVisibleTo(f); len(roles) > 0 && !isFieldProtoExclude(f, keep) {
+				v.restrict(roles, protoGoName(f))
+			}
+		}
+		for _, e := range n.Edges {
+			if roles := edgeVisibleTo(e); len(roles) > 0 {
+				v.restrict(roles, edgeProtoGoFields(n, e)...)
+			}
+		}
+		if len(v.Restricted) > 0 {
+			res[n] = v
+		}
+	}
+	// Nodes embedding redacted nodes are redacted too, until a fixpoint.
+	for changed := true; changed; {
+		changed = false
+		for _, n := range g.Nodes {
+			if res[n] != nil {
+				continue
+			}
+			for _, e := range n.Edges {
+				if t, _ := messageEdgeTarget(e, keep); t != nil && res[t] != nil {
+					res[n] = &Visibility{}
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	for _, n := range g.Nodes {
+		v := res[n]
+		if v == nil {
+			continue
+		}
+		for _, e := range n.Edges {
+			t, assoc := messageEdgeTarget(e, keep)
+			if t == nil || res[t] == nil {
+				continue
+			}
+			v.Nested = append(v.Nested, NestedRedaction{
+				GoField:  pascal(e.Name),
+				Target:   t.Name,
+				Repeated: !e.Unique,
+				Assoc:    assoc,
+			})
+		}
+	}
+	return res
+}
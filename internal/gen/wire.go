@@ -0,0 +1,52 @@
+package gen
+
+import (
+	entgen "entgo.io/ent/entc/gen"
+)
+
+// Layers with a generated Wire provider set.
+const (
+	wireLayerBiz     = "biz"
+	wireLayerData    = "data"
+	wireLayerService = "service"
+)
+
+// wireProviders returns the constructors of the usecases, repositories or
+// services generated in a layer, in schema order. The data layer also provides
+// the biz.Transaction shared by its repositories, and the service layer the
+// usecase interface of every service backed by a generated usecase.
+func wireProviders(g *entgen.Graph, layer string) []string {
+	var res []string
+	if layer == wireLayerData && hasRepoNodes(g) {
+		res = append(res, "NewTransaction")
+	}
+	for _, n := range g.Nodes {
+		switch {
+		case layer == wireLayerBiz && hasRepo(n):
+			res = append(res, "New"+n.Name+"Usecase")
+		case layer == wireLayerData && hasRepo(n):
+			res = append(res, "New"+n.Name+"Repo")
+		case layer == wireLayerService && hasCRUDService(n):
+			res = append(res, "New"+n.Name+"Service")
+			if hasRepo(n) {
+				res = append(res, "Provide"+n.Name+"Usecase")
+			}
+		}
+	}
+	return res
+}
+
+// wireUsecases returns the nodes whose service usecase interface is provided
+// by their generated biz usecase, which implements it as the repository
+// includes the methods of the CRUD service. wire.Bind would require the biz
+// constructors in the service provider set, so they are provided by typed
+// functions instead.
+func wireUsecases(g *entgen.Graph) []*entgen.Type {
+	var res []*entgen.Type
+	for _, n := range g.Nodes {
+		if hasCRUDService(n) && hasRepo(n) {
+			res = append(res, n)
+		}
+	}
+	return res
+}
@@ -88,14 +88,18 @@ func TestLazyEntIntegration(t *testing.T) {
 	// entGenDir: internal/tests/testenv/app/user/internal/data/ent
 
 	conf := lazyent.Config{
-		ProtoOut:       "internal/tests/testenv/api/v1",
-		ProtoPackage:   "user.v1",
-		GoPackage:      "lazyent-test-app/user/v1;v1",
-		BizOut:         "internal/tests/testenv/app/user/internal/biz",
-		ServiceOut:     "internal/tests/testenv/app/user/internal/service",
-		DataOut:        "internal/tests/testenv/app/user/internal/data",
-		SingleFile:     true,
-		ProtoValidator: lazyent.ProtoValidatorPGV,
+		ProtoOut:            "internal/tests/testenv/api/v1",
+		ProtoPackage:        "user.v1",
+		GoPackage:           "lazyent-test-app/user/v1;v1",
+		BizOut:              "internal/tests/testenv/app/user/internal/biz",
+		ServiceOut:          "internal/tests/testenv/app/user/internal/service",
+		DataOut:             "internal/tests/testenv/app/user/internal/data",
+		SingleFile:          true,
+		ProtoValidator:      lazyent.ProtoValidatorPGV,
+		DefaultEdgeStrategy: lazyent.BizPointerWithProtoID, // Global default, overridden by schema/edge annotations
+		KeepSensitiveInBiz:  true,
+		ErrorReasons:        true,
+		WireProviders:       true,
 	}
 
 	schemaPath := "./schema"
@@ -112,6 +116,23 @@ func TestLazyEntIntegration(t *testing.T) {
 		Header:  "// Code generated by ent, DO NOT EDIT.",
 	}
 
+	// Scaffolds are only written when missing, so remove them first to have
+	// this run regenerate them and compare the fresh output below.
+	scaffolds := []string{
+		"internal/tests/testenv/app/user/internal/biz/entities.go",
+		"internal/tests/testenv/app/user/internal/biz/repo.go",
+		"internal/tests/testenv/app/user/internal/biz/user_usecase.go",
+		"internal/tests/testenv/app/user/internal/biz/group_usecase.go",
+		"internal/tests/testenv/app/user/internal/data/repo.go",
+		"internal/tests/testenv/app/user/internal/service/user_service.go",
+		"internal/tests/testenv/app/user/internal/service/group_service.go",
+	}
+	for _, p := range scaffolds {
+		if err := os.Remove(filepath.Join(projectRoot, p)); err != nil && !os.IsNotExist(err) {
+			t.Fatalf("failed to remove scaffold %s: %v", p, err)
+		}
+	}
+
 	if err := entc.Generate(schemaPath, config, opts...); err != nil {
 		t.Fatalf("Generation failed: %v", err)
 	}
@@ -119,14 +140,23 @@ func TestLazyEntIntegration(t *testing.T) {
 	// 4. Verify Outputs against Golden files
 	// Files are in projectRoot/tests/testenv/...
 
-	filesToCheck := map[string]string{
-		"internal/tests/testenv/api/v1/dtos_gen.proto":                            "dtos_gen.proto.golden",
-		"internal/tests/testenv/app/user/internal/biz/entities_base_gen.go":       "entities_base_gen.go.golden",
-		"internal/tests/testenv/app/user/internal/service/service_mappers_gen.go": "service_mappers_gen.go.golden",
-		"internal/tests/testenv/app/user/internal/data/data_mappers_gen.go":       "data_mappers_gen.go.golden",
+	generated := []string{
+		"internal/tests/testenv/api/v1/dtos_gen.proto",
+		"internal/tests/testenv/api/v1/errors_gen.proto",
+		"internal/tests/testenv/app/user/internal/biz/entities_base_gen.go",
+		"internal/tests/testenv/app/user/internal/biz/repo_gen.go",
+		"internal/tests/testenv/app/user/internal/biz/usecases_gen.go",
+		"internal/tests/testenv/app/user/internal/biz/wire_gen_providers.go",
+		"internal/tests/testenv/app/user/internal/service/service_mappers_gen.go",
+		"internal/tests/testenv/app/user/internal/service/services_gen.go",
+		"internal/tests/testenv/app/user/internal/data/data_mappers_gen.go",
+		"internal/tests/testenv/app/user/internal/data/repo_gen.go",
+		"internal/tests/testenv/app/user/internal/data/wire_gen_providers.go",
+		"internal/tests/testenv/app/user/internal/service/wire_gen_providers.go",
 	}
+	filesToCheck := append(generated, scaffolds...)
 
-	for genRelPath := range filesToCheck {
+	for _, genRelPath := range filesToCheck {
 		fullGenPath := filepath.Join(projectRoot, genRelPath)
 		// Golden file is next to generated file? No, it's .golden extension.
 		// User provided golden files in `testenv`.
@@ -178,3 +208,41 @@ func TestLazyEntIntegration(t *testing.T) {
 		}
 	}
 }
+
+// Multi-file mode splits protos per node, which cannot hold the request
+// messages CRUD services share between nodes, so the combination is rejected
+// before anything is written.
+func TestLazyEntMultiFileCRUD(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get working directory: %v", err)
+	}
+	if err := os.Chdir(filepath.Join(wd, "testenv/app/user/internal/data/ent")); err != nil {
+		t.Fatalf("failed to chdir to ent gen dir: %v", err)
+	}
+	defer func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Fatalf("failed to restore working directory: %v", err)
+		}
+	}()
+
+	g, err := entc.LoadGraph("./schema", &gen.Config{
+		Package: "github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent",
+	})
+	if err != nil {
+		t.Fatalf("failed to load graph: %v", err)
+	}
+
+	ext := lazyent.NewExtension(lazyent.Config{
+		ProtoOut:   t.TempDir(),
+		BizOut:     t.TempDir(),
+		ServiceOut: t.TempDir(),
+		DataOut:    t.TempDir(),
+		SingleFile: false,
+	})
+	noop := gen.GenerateFunc(func(*gen.Graph) error { return nil })
+	err = ext.GenerateFiles(noop).Generate(g)
+	if err == nil || !strings.Contains(err.Error(), "CRUD services require Config.SingleFile") {
+		t.Fatalf("expected multi-file CRUD error, got %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// Assertions on the generated API surface. The behaviour of the generated Go
+// code is tested by the testenv packages themselves against sqlite; these
+// tests check the proto contract and the wire providers in the golden files.
+
+// goldenProto returns the generated dtos_gen.proto with whitespace collapsed.
+func goldenProto(t *testing.T) string {
+	t.Helper()
+	b, err := os.ReadFile("testenv/api/v1/dtos_gen.proto.golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Join(strings.Fields(string(b)), " ")
+}
+
+// assertProto fails unless the generated proto contains every want, compared
+// with whitespace collapsed.
+func assertProto(t *testing.T, wants ...string) {
+	t.Helper()
+	proto := goldenProto(t)
+	for _, want := range wants {
+		if !strings.Contains(proto, strings.Join(strings.Fields(want), " ")) {
+			t.Errorf("dtos_gen.proto: missing %q", want)
+		}
+	}
+}
+
+// Validation rules declared on a schema are defaults for the fields of a
+// matching type without rules of their own.
+func TestGeneratedValidationDefaults(t *testing.T) {
+	assertProto(t,
+		`string content = 5 [(validate.rules).string = { max_len: 10000 }];`, // Post schema default
+		`string title = 4 [(validate.rules).string = { min_len: 0 }];`,       // field rules win
+		`int32 age = 2 [(validate.rules).int32 = { gte: 0 }];`,               // structured field rules
+		`string nickname = 6 [(validate.rules).string = { min_len: 2, max_len: 20, ignore_empty: true }];`)
+}
+
+// Sub-resource requests carry the parent ID and validated target IDs.
+func TestGeneratedSubResourceRequests(t *testing.T) {
+	for _, msg := range []string{"AddGroupUsersRequest", "RemoveGroupUsersRequest"} {
+		assertProto(t, "message "+msg+` { string uuid = 1 [(validate.rules).string = { uuid: true }]; repeated string user_ids = 2 [(validate.rules).repeated = { items: { string: { uuid: true } } }]; }`)
+	}
+}
+
+// Every service backed by a generated usecase gets its usecase interface
+// provided next to its constructor.
+func TestGeneratedWireUsecaseProviders(t *testing.T) {
+	b, err := os.ReadFile("testenv/app/user/internal/service/wire_gen_providers.go.golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := string(b)
+	for _, name := range []string{"User", "Group"} {
+		for _, want := range []string{"\tNew" + name + "Service,\n", "\tProvide" + name + "Usecase,\n", "func Provide" + name + "Usecase(uc *biz." + name + "Usecase) " + name + "Usecase {"} {
+			if !strings.Contains(src, want) {
+				t.Errorf("service wire_gen_providers.go: missing %q", want)
+			}
+		}
+	}
+}
+
+// CRUD requests of a resource carry its name in place of the ID, bound to the
+// AIP path pattern.
+func TestGeneratedResourceNameRequests(t *testing.T) {
+	for _, msg := range []string{"GetUserRequest", "UpdateUserRequest", "DeleteUserRequest", "RestoreUserRequest", "ListUserPostsRequest"} {
+		assertProto(t, "message "+msg+" { string resource_name = 1;")
+	}
+	assertProto(t, `get: "/v1/{resource_name=users/*}"`, `post: "/v1/{resource_name=users/*}:restore"`, `get: "/v1/{resource_name=users/*}/posts"`)
+}
+
+// Updates of versioned entities require the expected version.
+func TestGeneratedUpdateVersion(t *testing.T) {
+	assertProto(t, `optional int32 version = 12; // 期望的当前版本，与服务端不一致时更新失败，必须设置`)
+}
@@ -0,0 +1,4111 @@
+// Code generated by lazyent. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: dtos_gen.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MembershipRole int32
+
+const (
+	MembershipRole_MEMBERSHIPROLE_UNSPECIFIED MembershipRole = 0
+	MembershipRole_MEMBERSHIPROLE_MEMBER      MembershipRole = 1
+	MembershipRole_MEMBERSHIPROLE_OWNER       MembershipRole = 2
+)
+
+// Enum value maps for MembershipRole.
+var (
+	MembershipRole_name = map[int32]string{
+		0: "MEMBERSHIPROLE_UNSPECIFIED",
+		1: "MEMBERSHIPROLE_MEMBER",
+		2: "MEMBERSHIPROLE_OWNER",
+	}
+	MembershipRole_value = map[string]int32{
+		"MEMBERSHIPROLE_UNSPECIFIED": 0,
+		"MEMBERSHIPROLE_MEMBER":      1,
+		"MEMBERSHIPROLE_OWNER":       2,
+	}
+)
+
+func (x MembershipRole) Enum() *MembershipRole {
+	p := new(MembershipRole)
+	*p = x
+	return p
+}
+
+func (x MembershipRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MembershipRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_dtos_gen_proto_enumTypes[0].Descriptor()
+}
+
+func (MembershipRole) Type() protoreflect.EnumType {
+	return &file_dtos_gen_proto_enumTypes[0]
+}
+
+func (x MembershipRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MembershipRole.Descriptor instead.
+func (MembershipRole) EnumDescriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{0}
+}
+
+type UserStatus int32
+
+const (
+	UserStatus_USERSTATUS_UNSPECIFIED UserStatus = 0
+	UserStatus_USERSTATUS_ACTIVE      UserStatus = 1
+	UserStatus_USERSTATUS_INACTIVE    UserStatus = 2
+	UserStatus_USERSTATUS_BANNED      UserStatus = 3
+)
+
+// Enum value maps for UserStatus.
+var (
+	UserStatus_name = map[int32]string{
+		0: "USERSTATUS_UNSPECIFIED",
+		1: "USERSTATUS_ACTIVE",
+		2: "USERSTATUS_INACTIVE",
+		3: "USERSTATUS_BANNED",
+	}
+	UserStatus_value = map[string]int32{
+		"USERSTATUS_UNSPECIFIED": 0,
+		"USERSTATUS_ACTIVE":      1,
+		"USERSTATUS_INACTIVE":    2,
+		"USERSTATUS_BANNED":      3,
+	}
+)
+
+func (x UserStatus) Enum() *UserStatus {
+	p := new(UserStatus)
+	*p = x
+	return p
+}
+
+func (x UserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_dtos_gen_proto_enumTypes[1].Descriptor()
+}
+
+func (UserStatus) Type() protoreflect.EnumType {
+	return &file_dtos_gen_proto_enumTypes[1]
+}
+
+func (x UserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserStatus.Descriptor instead.
+func (UserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{1}
+}
+
+type GroupOrderBy int32
+
+const (
+	GroupOrderBy_GROUPORDERBY_UNSPECIFIED GroupOrderBy = 0
+	GroupOrderBy_GROUPORDERBY_CREATED_AT  GroupOrderBy = 1
+	GroupOrderBy_GROUPORDERBY_UPDATED_AT  GroupOrderBy = 2
+	GroupOrderBy_GROUPORDERBY_NAME        GroupOrderBy = 3
+)
+
+// Enum value maps for GroupOrderBy.
+var (
+	GroupOrderBy_name = map[int32]string{
+		0: "GROUPORDERBY_UNSPECIFIED",
+		1: "GROUPORDERBY_CREATED_AT",
+		2: "GROUPORDERBY_UPDATED_AT",
+		3: "GROUPORDERBY_NAME",
+	}
+	GroupOrderBy_value = map[string]int32{
+		"GROUPORDERBY_UNSPECIFIED": 0,
+		"GROUPORDERBY_CREATED_AT":  1,
+		"GROUPORDERBY_UPDATED_AT":  2,
+		"GROUPORDERBY_NAME":        3,
+	}
+)
+
+func (x GroupOrderBy) Enum() *GroupOrderBy {
+	p := new(GroupOrderBy)
+	*p = x
+	return p
+}
+
+func (x GroupOrderBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupOrderBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_dtos_gen_proto_enumTypes[2].Descriptor()
+}
+
+func (GroupOrderBy) Type() protoreflect.EnumType {
+	return &file_dtos_gen_proto_enumTypes[2]
+}
+
+func (x GroupOrderBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupOrderBy.Descriptor instead.
+func (GroupOrderBy) EnumDescriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{2}
+}
+
+type UserOrderBy int32
+
+const (
+	UserOrderBy_USERORDERBY_UNSPECIFIED UserOrderBy = 0
+	UserOrderBy_USERORDERBY_CREATED_AT  UserOrderBy = 1
+	UserOrderBy_USERORDERBY_UPDATED_AT  UserOrderBy = 2
+	UserOrderBy_USERORDERBY_NAME        UserOrderBy = 3
+	UserOrderBy_USERORDERBY_AGE         UserOrderBy = 4
+	UserOrderBy_USERORDERBY_VERSION     UserOrderBy = 5
+)
+
+// Enum value maps for UserOrderBy.
+var (
+	UserOrderBy_name = map[int32]string{
+		0: "USERORDERBY_UNSPECIFIED",
+		1: "USERORDERBY_CREATED_AT",
+		2: "USERORDERBY_UPDATED_AT",
+		3: "USERORDERBY_NAME",
+		4: "USERORDERBY_AGE",
+		5: "USERORDERBY_VERSION",
+	}
+	UserOrderBy_value = map[string]int32{
+		"USERORDERBY_UNSPECIFIED": 0,
+		"USERORDERBY_CREATED_AT":  1,
+		"USERORDERBY_UPDATED_AT":  2,
+		"USERORDERBY_NAME":        3,
+		"USERORDERBY_AGE":         4,
+		"USERORDERBY_VERSION":     5,
+	}
+)
+
+func (x UserOrderBy) Enum() *UserOrderBy {
+	p := new(UserOrderBy)
+	*p = x
+	return p
+}
+
+func (x UserOrderBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserOrderBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_dtos_gen_proto_enumTypes[3].Descriptor()
+}
+
+func (UserOrderBy) Type() protoreflect.EnumType {
+	return &file_dtos_gen_proto_enumTypes[3]
+}
+
+func (x UserOrderBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserOrderBy.Descriptor instead.
+func (UserOrderBy) EnumDescriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{3}
+}
+
+type PostOrderBy int32
+
+const (
+	PostOrderBy_POSTORDERBY_UNSPECIFIED PostOrderBy = 0
+	PostOrderBy_POSTORDERBY_CREATED_AT  PostOrderBy = 1
+	PostOrderBy_POSTORDERBY_UPDATED_AT  PostOrderBy = 2
+	PostOrderBy_POSTORDERBY_TITLE       PostOrderBy = 3
+	PostOrderBy_POSTORDERBY_CONTENT     PostOrderBy = 4
+)
+
+// Enum value maps for PostOrderBy.
+var (
+	PostOrderBy_name = map[int32]string{
+		0: "POSTORDERBY_UNSPECIFIED",
+		1: "POSTORDERBY_CREATED_AT",
+		2: "POSTORDERBY_UPDATED_AT",
+		3: "POSTORDERBY_TITLE",
+		4: "POSTORDERBY_CONTENT",
+	}
+	PostOrderBy_value = map[string]int32{
+		"POSTORDERBY_UNSPECIFIED": 0,
+		"POSTORDERBY_CREATED_AT":  1,
+		"POSTORDERBY_UPDATED_AT":  2,
+		"POSTORDERBY_TITLE":       3,
+		"POSTORDERBY_CONTENT":     4,
+	}
+)
+
+func (x PostOrderBy) Enum() *PostOrderBy {
+	p := new(PostOrderBy)
+	*p = x
+	return p
+}
+
+func (x PostOrderBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostOrderBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_dtos_gen_proto_enumTypes[4].Descriptor()
+}
+
+func (PostOrderBy) Type() protoreflect.EnumType {
+	return &file_dtos_gen_proto_enumTypes[4]
+}
+
+func (x PostOrderBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostOrderBy.Descriptor instead.
+func (PostOrderBy) EnumDescriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{4}
+}
+
+type Group struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                            // 数据库主键
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 创建时间
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // 更新时间
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	TenantId      string                 `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 所属租户，只读
+	ModeratorIds  []string               `protobuf:"bytes,6,rep,name=moderator_ids,json=moderatorIds,proto3" json:"moderator_ids,omitempty"`
+	Memberships   []*GroupMembership     `protobuf:"bytes,7,rep,name=memberships,proto3" json:"memberships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_dtos_gen_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Group) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Group) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Group) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Group) GetModeratorIds() []string {
+	if x != nil {
+		return x.ModeratorIds
+	}
+	return nil
+}
+
+func (x *Group) GetMemberships() []*GroupMembership {
+	if x != nil {
+		return x.Memberships
+	}
+	return nil
+}
+
+type GroupMembership struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Group 经由 Membership 的关联
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`   // 创建时间
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`   // 更新时间
+	Role          MembershipRole         `protobuf:"varint,4,opt,name=role,proto3,enum=user.v1.MembershipRole" json:"role,omitempty"` // 成员角色
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`      // 加入时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMembership) Reset() {
+	*x = GroupMembership{}
+	mi := &file_dtos_gen_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMembership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMembership) ProtoMessage() {}
+
+func (x *GroupMembership) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMembership.ProtoReflect.Descriptor instead.
+func (*GroupMembership) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GroupMembership) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GroupMembership) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GroupMembership) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GroupMembership) GetRole() MembershipRole {
+	if x != nil {
+		return x.Role
+	}
+	return MembershipRole_MEMBERSHIPROLE_UNSPECIFIED
+}
+
+func (x *GroupMembership) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+type Membership struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                              // 数据库主键
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`   // 创建时间
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`   // 更新时间
+	Role          MembershipRole         `protobuf:"varint,4,opt,name=role,proto3,enum=user.v1.MembershipRole" json:"role,omitempty"` // 成员角色
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`      // 加入时间
+	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,7,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Membership) Reset() {
+	*x = Membership{}
+	mi := &file_dtos_gen_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Membership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Membership) ProtoMessage() {}
+
+func (x *Membership) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Membership.ProtoReflect.Descriptor instead.
+func (*Membership) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Membership) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Membership) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Membership) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Membership) GetRole() MembershipRole {
+	if x != nil {
+		return x.Role
+	}
+	return MembershipRole_MEMBERSHIPROLE_UNSPECIFIED
+}
+
+func (x *Membership) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+func (x *Membership) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Membership) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type Post struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                            // 资源名称，格式为 users/{user}/posts/{post}
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 创建时间
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // 更新时间
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Author        string                 `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Post) Reset() {
+	*x = Post{}
+	mi := &file_dtos_gen_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Post) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Post) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Post) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Post) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Post) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Post) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type User struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ResourceName     string                 `protobuf:"bytes,1,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"` // 资源名称，格式为 users/{user}
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`          // 创建时间
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`          // 更新时间
+	Name             string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Age              int32                  `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty"`
+	Nickname         string                 `protobuf:"bytes,6,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	UserScore        uint32                 `protobuf:"varint,7,opt,name=user_score,json=userScore,proto3" json:"user_score,omitempty"`
+	IsVerified       bool                   `protobuf:"varint,8,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	Tags             []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`                                                    // 用户标签
+	TestUuid         string                 `protobuf:"bytes,10,opt,name=test_uuid,json=testUuid,proto3" json:"test_uuid,omitempty"`                           // 测试UUID
+	TestNillableUuid string                 `protobuf:"bytes,11,opt,name=test_nillable_uuid,json=testNillableUuid,proto3" json:"test_nillable_uuid,omitempty"` // 测试UUID2
+	Status           UserStatus             `protobuf:"varint,12,opt,name=status,proto3,enum=user.v1.UserStatus" json:"status,omitempty"`
+	Role             string                 `protobuf:"bytes,13,opt,name=role,proto3" json:"role,omitempty"`        // 用户权限组
+	Version          int32                  `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"` // 乐观锁版本号
+	PostIds          []string               `protobuf:"bytes,15,rep,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+	GroupIds         []string               `protobuf:"bytes,16,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
+	PostCount        int64                  `protobuf:"varint,17,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`       // posts 的数量
+	GroupCount       int64                  `protobuf:"varint,18,opt,name=group_count,json=groupCount,proto3" json:"group_count,omitempty"`    // groups 的数量
+	FriendCount      int64                  `protobuf:"varint,19,opt,name=friend_count,json=friendCount,proto3" json:"friend_count,omitempty"` // friends 的数量
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_dtos_gen_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *User) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *User) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *User) GetUserScore() uint32 {
+	if x != nil {
+		return x.UserScore
+	}
+	return 0
+}
+
+func (x *User) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *User) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *User) GetTestUuid() string {
+	if x != nil {
+		return x.TestUuid
+	}
+	return ""
+}
+
+func (x *User) GetTestNillableUuid() string {
+	if x != nil {
+		return x.TestNillableUuid
+	}
+	return ""
+}
+
+func (x *User) GetStatus() UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UserStatus_USERSTATUS_UNSPECIFIED
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *User) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *User) GetPostIds() []string {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+func (x *User) GetGroupIds() []string {
+	if x != nil {
+		return x.GroupIds
+	}
+	return nil
+}
+
+func (x *User) GetPostCount() int64 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+func (x *User) GetGroupCount() int64 {
+	if x != nil {
+		return x.GroupCount
+	}
+	return 0
+}
+
+func (x *User) GetFriendCount() int64 {
+	if x != nil {
+		return x.FriendCount
+	}
+	return 0
+}
+
+type CreateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ModeratorIds  []string               `protobuf:"bytes,2,rep,name=moderator_ids,json=moderatorIds,proto3" json:"moderator_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetModeratorIds() []string {
+	if x != nil {
+		return x.ModeratorIds
+	}
+	return nil
+}
+
+type CreateGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupReply) Reset() {
+	*x = CreateGroupReply{}
+	mi := &file_dtos_gen_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupReply) ProtoMessage() {}
+
+func (x *CreateGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupReply.ProtoReflect.Descriptor instead.
+func (*CreateGroupReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateGroupReply) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type GetGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetGroupRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type GetGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupReply) Reset() {
+	*x = GetGroupReply{}
+	mi := &file_dtos_gen_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupReply) ProtoMessage() {}
+
+func (x *GetGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupReply.ProtoReflect.Descriptor instead.
+func (*GetGroupReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetGroupReply) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type GetGroupByNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupByNameRequest) Reset() {
+	*x = GetGroupByNameRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupByNameRequest) ProtoMessage() {}
+
+func (x *GetGroupByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupByNameRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetGroupByNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetGroupByNameReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupByNameReply) Reset() {
+	*x = GetGroupByNameReply{}
+	mi := &file_dtos_gen_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupByNameReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupByNameReply) ProtoMessage() {}
+
+func (x *GetGroupByNameReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupByNameReply.ProtoReflect.Descriptor instead.
+func (*GetGroupByNameReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetGroupByNameReply) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type UpdateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"` // 数据库主键
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	ModeratorIds  []string               `protobuf:"bytes,3,rep,name=moderator_ids,json=moderatorIds,proto3" json:"moderator_ids,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // 更新的字段路径，为空时更新请求中出现的字段
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRequest) ProtoMessage() {}
+
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateGroupRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetModeratorIds() []string {
+	if x != nil {
+		return x.ModeratorIds
+	}
+	return nil
+}
+
+func (x *UpdateGroupRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupReply) Reset() {
+	*x = UpdateGroupReply{}
+	mi := &file_dtos_gen_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupReply) ProtoMessage() {}
+
+func (x *UpdateGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupReply.ProtoReflect.Descriptor instead.
+func (*UpdateGroupReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateGroupReply) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type DeleteGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupRequest) Reset() {
+	*x = DeleteGroupRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRequest) ProtoMessage() {}
+
+func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteGroupRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type DeleteGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupReply) Reset() {
+	*x = DeleteGroupReply{}
+	mi := &file_dtos_gen_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupReply) ProtoMessage() {}
+
+func (x *DeleteGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupReply.ProtoReflect.Descriptor instead.
+func (*DeleteGroupReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{14}
+}
+
+type ExactStringFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Eq            *string                `protobuf:"bytes,1,opt,name=eq,proto3,oneof" json:"eq,omitempty"`
+	In            []string               `protobuf:"bytes,2,rep,name=in,proto3" json:"in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExactStringFilter) Reset() {
+	*x = ExactStringFilter{}
+	mi := &file_dtos_gen_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExactStringFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExactStringFilter) ProtoMessage() {}
+
+func (x *ExactStringFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExactStringFilter.ProtoReflect.Descriptor instead.
+func (*ExactStringFilter) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExactStringFilter) GetEq() string {
+	if x != nil && x.Eq != nil {
+		return *x.Eq
+	}
+	return ""
+}
+
+func (x *ExactStringFilter) GetIn() []string {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+type TimestampFilter struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Eq            *timestamppb.Timestamp   `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	In            []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=in,proto3" json:"in,omitempty"`
+	Gt            *timestamppb.Timestamp   `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Gte           *timestamppb.Timestamp   `protobuf:"bytes,4,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lt            *timestamppb.Timestamp   `protobuf:"bytes,5,opt,name=lt,proto3" json:"lt,omitempty"`
+	Lte           *timestamppb.Timestamp   `protobuf:"bytes,6,opt,name=lte,proto3" json:"lte,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimestampFilter) Reset() {
+	*x = TimestampFilter{}
+	mi := &file_dtos_gen_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimestampFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimestampFilter) ProtoMessage() {}
+
+func (x *TimestampFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimestampFilter.ProtoReflect.Descriptor instead.
+func (*TimestampFilter) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TimestampFilter) GetEq() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *TimestampFilter) GetIn() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *TimestampFilter) GetGt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *TimestampFilter) GetGte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *TimestampFilter) GetLt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *TimestampFilter) GetLte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type StringFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Eq            *string                `protobuf:"bytes,1,opt,name=eq,proto3,oneof" json:"eq,omitempty"`
+	In            []string               `protobuf:"bytes,2,rep,name=in,proto3" json:"in,omitempty"`
+	Contains      *string                `protobuf:"bytes,3,opt,name=contains,proto3,oneof" json:"contains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringFilter) Reset() {
+	*x = StringFilter{}
+	mi := &file_dtos_gen_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringFilter) ProtoMessage() {}
+
+func (x *StringFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringFilter.ProtoReflect.Descriptor instead.
+func (*StringFilter) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StringFilter) GetEq() string {
+	if x != nil && x.Eq != nil {
+		return *x.Eq
+	}
+	return ""
+}
+
+func (x *StringFilter) GetIn() []string {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *StringFilter) GetContains() string {
+	if x != nil && x.Contains != nil {
+		return *x.Contains
+	}
+	return ""
+}
+
+type GroupFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Group 列表过滤条件，未设置的字段不参与过滤
+	Uuid          *ExactStringFilter `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	CreatedAt     *TimestampFilter   `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *TimestampFilter   `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Name          *StringFilter      `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupFilter) Reset() {
+	*x = GroupFilter{}
+	mi := &file_dtos_gen_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupFilter) ProtoMessage() {}
+
+func (x *GroupFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupFilter.ProtoReflect.Descriptor instead.
+func (*GroupFilter) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GroupFilter) GetUuid() *ExactStringFilter {
+	if x != nil {
+		return x.Uuid
+	}
+	return nil
+}
+
+func (x *GroupFilter) GetCreatedAt() *TimestampFilter {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GroupFilter) GetUpdatedAt() *TimestampFilter {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GroupFilter) GetName() *StringFilter {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+type ListGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 每页数量，为 0 时使用默认值
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // 上一页返回的 next_page_token，为空时从第一页开始
+	Filter        *GroupFilter           `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy       GroupOrderBy           `protobuf:"varint,4,opt,name=order_by,json=orderBy,proto3,enum=user.v1.GroupOrderBy" json:"order_by,omitempty"` // 排序字段，未指定时按 ID 排序
+	Desc          bool                   `protobuf:"varint,5,opt,name=desc,proto3" json:"desc,omitempty"`                                                // 是否降序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsRequest) Reset() {
+	*x = ListGroupsRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsRequest) ProtoMessage() {}
+
+func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListGroupsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGroupsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListGroupsRequest) GetFilter() *GroupFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListGroupsRequest) GetOrderBy() GroupOrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return GroupOrderBy_GROUPORDERBY_UNSPECIFIED
+}
+
+func (x *ListGroupsRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+type ListGroupsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*Group               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 下一页的 page_token，为空时没有更多数据
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsReply) Reset() {
+	*x = ListGroupsReply{}
+	mi := &file_dtos_gen_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsReply) ProtoMessage() {}
+
+func (x *ListGroupsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsReply.ProtoReflect.Descriptor instead.
+func (*ListGroupsReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListGroupsReply) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *ListGroupsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Int32Filter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Eq            *int32                 `protobuf:"varint,1,opt,name=eq,proto3,oneof" json:"eq,omitempty"`
+	In            []int32                `protobuf:"varint,2,rep,packed,name=in,proto3" json:"in,omitempty"`
+	Gt            *int32                 `protobuf:"varint,3,opt,name=gt,proto3,oneof" json:"gt,omitempty"`
+	Gte           *int32                 `protobuf:"varint,4,opt,name=gte,proto3,oneof" json:"gte,omitempty"`
+	Lt            *int32                 `protobuf:"varint,5,opt,name=lt,proto3,oneof" json:"lt,omitempty"`
+	Lte           *int32                 `protobuf:"varint,6,opt,name=lte,proto3,oneof" json:"lte,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Int32Filter) Reset() {
+	*x = Int32Filter{}
+	mi := &file_dtos_gen_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Int32Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int32Filter) ProtoMessage() {}
+
+func (x *Int32Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int32Filter.ProtoReflect.Descriptor instead.
+func (*Int32Filter) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Int32Filter) GetEq() int32 {
+	if x != nil && x.Eq != nil {
+		return *x.Eq
+	}
+	return 0
+}
+
+func (x *Int32Filter) GetIn() []int32 {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *Int32Filter) GetGt() int32 {
+	if x != nil && x.Gt != nil {
+		return *x.Gt
+	}
+	return 0
+}
+
+func (x *Int32Filter) GetGte() int32 {
+	if x != nil && x.Gte != nil {
+		return *x.Gte
+	}
+	return 0
+}
+
+func (x *Int32Filter) GetLt() int32 {
+	if x != nil && x.Lt != nil {
+		return *x.Lt
+	}
+	return 0
+}
+
+func (x *Int32Filter) GetLte() int32 {
+	if x != nil && x.Lte != nil {
+		return *x.Lte
+	}
+	return 0
+}
+
+type Uint32Filter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Eq            *uint32                `protobuf:"varint,1,opt,name=eq,proto3,oneof" json:"eq,omitempty"`
+	In            []uint32               `protobuf:"varint,2,rep,packed,name=in,proto3" json:"in,omitempty"`
+	Gt            *uint32                `protobuf:"varint,3,opt,name=gt,proto3,oneof" json:"gt,omitempty"`
+	Gte           *uint32                `protobuf:"varint,4,opt,name=gte,proto3,oneof" json:"gte,omitempty"`
+	Lt            *uint32                `protobuf:"varint,5,opt,name=lt,proto3,oneof" json:"lt,omitempty"`
+	Lte           *uint32                `protobuf:"varint,6,opt,name=lte,proto3,oneof" json:"lte,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Uint32Filter) Reset() {
+	*x = Uint32Filter{}
+	mi := &file_dtos_gen_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Uint32Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Uint32Filter) ProtoMessage() {}
+
+func (x *Uint32Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Uint32Filter.ProtoReflect.Descriptor instead.
+func (*Uint32Filter) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Uint32Filter) GetEq() uint32 {
+	if x != nil && x.Eq != nil {
+		return *x.Eq
+	}
+	return 0
+}
+
+func (x *Uint32Filter) GetIn() []uint32 {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *Uint32Filter) GetGt() uint32 {
+	if x != nil && x.Gt != nil {
+		return *x.Gt
+	}
+	return 0
+}
+
+func (x *Uint32Filter) GetGte() uint32 {
+	if x != nil && x.Gte != nil {
+		return *x.Gte
+	}
+	return 0
+}
+
+func (x *Uint32Filter) GetLt() uint32 {
+	if x != nil && x.Lt != nil {
+		return *x.Lt
+	}
+	return 0
+}
+
+func (x *Uint32Filter) GetLte() uint32 {
+	if x != nil && x.Lte != nil {
+		return *x.Lte
+	}
+	return 0
+}
+
+type BoolFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Eq            *bool                  `protobuf:"varint,1,opt,name=eq,proto3,oneof" json:"eq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoolFilter) Reset() {
+	*x = BoolFilter{}
+	mi := &file_dtos_gen_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoolFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoolFilter) ProtoMessage() {}
+
+func (x *BoolFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoolFilter.ProtoReflect.Descriptor instead.
+func (*BoolFilter) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BoolFilter) GetEq() bool {
+	if x != nil && x.Eq != nil {
+		return *x.Eq
+	}
+	return false
+}
+
+type UserStatusFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Eq            *UserStatus            `protobuf:"varint,1,opt,name=eq,proto3,enum=user.v1.UserStatus,oneof" json:"eq,omitempty"`
+	In            []UserStatus           `protobuf:"varint,2,rep,packed,name=in,proto3,enum=user.v1.UserStatus" json:"in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserStatusFilter) Reset() {
+	*x = UserStatusFilter{}
+	mi := &file_dtos_gen_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserStatusFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStatusFilter) ProtoMessage() {}
+
+func (x *UserStatusFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStatusFilter.ProtoReflect.Descriptor instead.
+func (*UserStatusFilter) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UserStatusFilter) GetEq() UserStatus {
+	if x != nil && x.Eq != nil {
+		return *x.Eq
+	}
+	return UserStatus_USERSTATUS_UNSPECIFIED
+}
+
+func (x *UserStatusFilter) GetIn() []UserStatus {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+type UserFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User 列表过滤条件，未设置的字段不参与过滤
+	Uuid             *ExactStringFilter `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	CreatedAt        *TimestampFilter   `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *TimestampFilter   `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Name             *StringFilter      `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Age              *Int32Filter       `protobuf:"bytes,5,opt,name=age,proto3" json:"age,omitempty"`
+	Nickname         *StringFilter      `protobuf:"bytes,6,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	UserScore        *Uint32Filter      `protobuf:"bytes,7,opt,name=user_score,json=userScore,proto3" json:"user_score,omitempty"`
+	IsVerified       *BoolFilter        `protobuf:"bytes,8,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	TestUuid         *ExactStringFilter `protobuf:"bytes,9,opt,name=test_uuid,json=testUuid,proto3" json:"test_uuid,omitempty"`
+	TestNillableUuid *ExactStringFilter `protobuf:"bytes,10,opt,name=test_nillable_uuid,json=testNillableUuid,proto3" json:"test_nillable_uuid,omitempty"`
+	Status           *UserStatusFilter  `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	Role             *ExactStringFilter `protobuf:"bytes,12,opt,name=role,proto3" json:"role,omitempty"`
+	Version          *Int32Filter       `protobuf:"bytes,13,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UserFilter) Reset() {
+	*x = UserFilter{}
+	mi := &file_dtos_gen_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFilter) ProtoMessage() {}
+
+func (x *UserFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFilter.ProtoReflect.Descriptor instead.
+func (*UserFilter) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UserFilter) GetUuid() *ExactStringFilter {
+	if x != nil {
+		return x.Uuid
+	}
+	return nil
+}
+
+func (x *UserFilter) GetCreatedAt() *TimestampFilter {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserFilter) GetUpdatedAt() *TimestampFilter {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *UserFilter) GetName() *StringFilter {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UserFilter) GetAge() *Int32Filter {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *UserFilter) GetNickname() *StringFilter {
+	if x != nil {
+		return x.Nickname
+	}
+	return nil
+}
+
+func (x *UserFilter) GetUserScore() *Uint32Filter {
+	if x != nil {
+		return x.UserScore
+	}
+	return nil
+}
+
+func (x *UserFilter) GetIsVerified() *BoolFilter {
+	if x != nil {
+		return x.IsVerified
+	}
+	return nil
+}
+
+func (x *UserFilter) GetTestUuid() *ExactStringFilter {
+	if x != nil {
+		return x.TestUuid
+	}
+	return nil
+}
+
+func (x *UserFilter) GetTestNillableUuid() *ExactStringFilter {
+	if x != nil {
+		return x.TestNillableUuid
+	}
+	return nil
+}
+
+func (x *UserFilter) GetStatus() *UserStatusFilter {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UserFilter) GetRole() *ExactStringFilter {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+func (x *UserFilter) GetVersion() *Int32Filter {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type ListGroupUsersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Uuid           string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 每页数量，为 0 时使用默认值
+	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // 上一页返回的 next_page_token，为空时从第一页开始
+	Filter         *UserFilter            `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy        UserOrderBy            `protobuf:"varint,5,opt,name=order_by,json=orderBy,proto3,enum=user.v1.UserOrderBy" json:"order_by,omitempty"` // 排序字段，未指定时按 ID 排序
+	Desc           bool                   `protobuf:"varint,6,opt,name=desc,proto3" json:"desc,omitempty"`                                               // 是否降序
+	IncludeDeleted bool                   `protobuf:"varint,7,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`     // 是否包含已软删除的 User
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListGroupUsersRequest) Reset() {
+	*x = ListGroupUsersRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupUsersRequest) ProtoMessage() {}
+
+func (x *ListGroupUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupUsersRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListGroupUsersRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ListGroupUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGroupUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListGroupUsersRequest) GetFilter() *UserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListGroupUsersRequest) GetOrderBy() UserOrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return UserOrderBy_USERORDERBY_UNSPECIFIED
+}
+
+func (x *ListGroupUsersRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *ListGroupUsersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListGroupUsersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 下一页的 page_token，为空时没有更多数据
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupUsersReply) Reset() {
+	*x = ListGroupUsersReply{}
+	mi := &file_dtos_gen_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupUsersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupUsersReply) ProtoMessage() {}
+
+func (x *ListGroupUsersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupUsersReply.ProtoReflect.Descriptor instead.
+func (*ListGroupUsersReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListGroupUsersReply) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListGroupUsersReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type AddGroupUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupUsersRequest) Reset() {
+	*x = AddGroupUsersRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupUsersRequest) ProtoMessage() {}
+
+func (x *AddGroupUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupUsersRequest.ProtoReflect.Descriptor instead.
+func (*AddGroupUsersRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AddGroupUsersRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *AddGroupUsersRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type AddGroupUsersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupUsersReply) Reset() {
+	*x = AddGroupUsersReply{}
+	mi := &file_dtos_gen_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupUsersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupUsersReply) ProtoMessage() {}
+
+func (x *AddGroupUsersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupUsersReply.ProtoReflect.Descriptor instead.
+func (*AddGroupUsersReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{29}
+}
+
+type RemoveGroupUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupUsersRequest) Reset() {
+	*x = RemoveGroupUsersRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupUsersRequest) ProtoMessage() {}
+
+func (x *RemoveGroupUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupUsersRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGroupUsersRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RemoveGroupUsersRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *RemoveGroupUsersRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type RemoveGroupUsersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupUsersReply) Reset() {
+	*x = RemoveGroupUsersReply{}
+	mi := &file_dtos_gen_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupUsersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupUsersReply) ProtoMessage() {}
+
+func (x *RemoveGroupUsersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupUsersReply.ProtoReflect.Descriptor instead.
+func (*RemoveGroupUsersReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{31}
+}
+
+type CreateUserRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Age              int32                  `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty"`
+	Nickname         *string                `protobuf:"bytes,3,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
+	UserScore        *uint32                `protobuf:"varint,4,opt,name=user_score,json=userScore,proto3,oneof" json:"user_score,omitempty"`
+	IsVerified       *bool                  `protobuf:"varint,5,opt,name=is_verified,json=isVerified,proto3,oneof" json:"is_verified,omitempty"`
+	Tags             []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                                                         // 用户标签
+	TestUuid         *string                `protobuf:"bytes,7,opt,name=test_uuid,json=testUuid,proto3,oneof" json:"test_uuid,omitempty"`                           // 测试UUID
+	TestNillableUuid *string                `protobuf:"bytes,8,opt,name=test_nillable_uuid,json=testNillableUuid,proto3,oneof" json:"test_nillable_uuid,omitempty"` // 测试UUID2
+	Status           UserStatus             `protobuf:"varint,9,opt,name=status,proto3,enum=user.v1.UserStatus" json:"status,omitempty"`
+	Role             *string                `protobuf:"bytes,10,opt,name=role,proto3,oneof" json:"role,omitempty"` // 用户权限组
+	PostIds          []string               `protobuf:"bytes,11,rep,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *CreateUserRequest) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetUserScore() uint32 {
+	if x != nil && x.UserScore != nil {
+		return *x.UserScore
+	}
+	return 0
+}
+
+func (x *CreateUserRequest) GetIsVerified() bool {
+	if x != nil && x.IsVerified != nil {
+		return *x.IsVerified
+	}
+	return false
+}
+
+func (x *CreateUserRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateUserRequest) GetTestUuid() string {
+	if x != nil && x.TestUuid != nil {
+		return *x.TestUuid
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetTestNillableUuid() string {
+	if x != nil && x.TestNillableUuid != nil {
+		return *x.TestNillableUuid
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetStatus() UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UserStatus_USERSTATUS_UNSPECIFIED
+}
+
+func (x *CreateUserRequest) GetRole() string {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetPostIds() []string {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+type CreateUserReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserReply) Reset() {
+	*x = CreateUserReply{}
+	mi := &file_dtos_gen_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserReply) ProtoMessage() {}
+
+func (x *CreateUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserReply.ProtoReflect.Descriptor instead.
+func (*CreateUserReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateUserReply) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceName  string                 `protobuf:"bytes,1,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"` // 资源名称，格式为 users/{user}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetUserRequest) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+type GetUserReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReply) Reset() {
+	*x = GetUserReply{}
+	mi := &file_dtos_gen_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReply) ProtoMessage() {}
+
+func (x *GetUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReply.ProtoReflect.Descriptor instead.
+func (*GetUserReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetUserReply) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetUserByNameAndAgeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Age           int32                  `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByNameAndAgeRequest) Reset() {
+	*x = GetUserByNameAndAgeRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByNameAndAgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByNameAndAgeRequest) ProtoMessage() {}
+
+func (x *GetUserByNameAndAgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByNameAndAgeRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByNameAndAgeRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetUserByNameAndAgeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetUserByNameAndAgeRequest) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+type GetUserByNameAndAgeReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByNameAndAgeReply) Reset() {
+	*x = GetUserByNameAndAgeReply{}
+	mi := &file_dtos_gen_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByNameAndAgeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByNameAndAgeReply) ProtoMessage() {}
+
+func (x *GetUserByNameAndAgeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByNameAndAgeReply.ProtoReflect.Descriptor instead.
+func (*GetUserByNameAndAgeReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetUserByNameAndAgeReply) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UpdateUserRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ResourceName     string                 `protobuf:"bytes,1,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"` // 资源名称，格式为 users/{user}
+	Name             *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Age              *int32                 `protobuf:"varint,2,opt,name=age,proto3,oneof" json:"age,omitempty"`
+	Nickname         *string                `protobuf:"bytes,4,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
+	UserScore        *uint32                `protobuf:"varint,5,opt,name=user_score,json=userScore,proto3,oneof" json:"user_score,omitempty"`
+	IsVerified       *bool                  `protobuf:"varint,6,opt,name=is_verified,json=isVerified,proto3,oneof" json:"is_verified,omitempty"`
+	Tags             []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`                                                         // 用户标签
+	TestUuid         *string                `protobuf:"bytes,8,opt,name=test_uuid,json=testUuid,proto3,oneof" json:"test_uuid,omitempty"`                           // 测试UUID
+	TestNillableUuid *string                `protobuf:"bytes,9,opt,name=test_nillable_uuid,json=testNillableUuid,proto3,oneof" json:"test_nillable_uuid,omitempty"` // 测试UUID2
+	Status           *UserStatus            `protobuf:"varint,10,opt,name=status,proto3,enum=user.v1.UserStatus,oneof" json:"status,omitempty"`
+	Role             *string                `protobuf:"bytes,11,opt,name=role,proto3,oneof" json:"role,omitempty"`                         // 用户权限组
+	Version          *int32                 `protobuf:"varint,12,opt,name=version,proto3,oneof" json:"version,omitempty"`                  // 期望的当前版本，与服务端不一致时更新失败，必须设置
+	UpdateMask       *fieldmaskpb.FieldMask `protobuf:"bytes,13,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // 更新的字段路径，为空时更新请求中出现的字段
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateUserRequest) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetAge() int32 {
+	if x != nil && x.Age != nil {
+		return *x.Age
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetUserScore() uint32 {
+	if x != nil && x.UserScore != nil {
+		return *x.UserScore
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetIsVerified() bool {
+	if x != nil && x.IsVerified != nil {
+		return *x.IsVerified
+	}
+	return false
+}
+
+func (x *UpdateUserRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetTestUuid() string {
+	if x != nil && x.TestUuid != nil {
+		return *x.TestUuid
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetTestNillableUuid() string {
+	if x != nil && x.TestNillableUuid != nil {
+		return *x.TestNillableUuid
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetStatus() UserStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return UserStatus_USERSTATUS_UNSPECIFIED
+}
+
+func (x *UpdateUserRequest) GetRole() string {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateUserReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserReply) Reset() {
+	*x = UpdateUserReply{}
+	mi := &file_dtos_gen_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserReply) ProtoMessage() {}
+
+func (x *UpdateUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserReply.ProtoReflect.Descriptor instead.
+func (*UpdateUserReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateUserReply) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceName  string                 `protobuf:"bytes,1,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"` // 资源名称，格式为 users/{user}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteUserRequest) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+type DeleteUserReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserReply) Reset() {
+	*x = DeleteUserReply{}
+	mi := &file_dtos_gen_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserReply) ProtoMessage() {}
+
+func (x *DeleteUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserReply.ProtoReflect.Descriptor instead.
+func (*DeleteUserReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{41}
+}
+
+type ListUsersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PageSize       int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 每页数量，为 0 时使用默认值
+	PageToken      string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // 上一页返回的 next_page_token，为空时从第一页开始
+	Filter         *UserFilter            `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy        UserOrderBy            `protobuf:"varint,4,opt,name=order_by,json=orderBy,proto3,enum=user.v1.UserOrderBy" json:"order_by,omitempty"` // 排序字段，未指定时按 ID 排序
+	Desc           bool                   `protobuf:"varint,5,opt,name=desc,proto3" json:"desc,omitempty"`                                               // 是否降序
+	IncludeDeleted bool                   `protobuf:"varint,6,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`     // 是否包含已软删除的 User
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetFilter() *UserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListUsersRequest) GetOrderBy() UserOrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return UserOrderBy_USERORDERBY_UNSPECIFIED
+}
+
+func (x *ListUsersRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *ListUsersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListUsersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 下一页的 page_token，为空时没有更多数据
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersReply) Reset() {
+	*x = ListUsersReply{}
+	mi := &file_dtos_gen_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersReply) ProtoMessage() {}
+
+func (x *ListUsersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersReply.ProtoReflect.Descriptor instead.
+func (*ListUsersReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListUsersReply) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RestoreUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceName  string                 `protobuf:"bytes,1,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"` // 资源名称，格式为 users/{user}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserRequest) Reset() {
+	*x = RestoreUserRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserRequest) ProtoMessage() {}
+
+func (x *RestoreUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RestoreUserRequest) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+type RestoreUserReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserReply) Reset() {
+	*x = RestoreUserReply{}
+	mi := &file_dtos_gen_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserReply) ProtoMessage() {}
+
+func (x *RestoreUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserReply.ProtoReflect.Descriptor instead.
+func (*RestoreUserReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RestoreUserReply) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type StreamUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *UserFilter            `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	BatchSize     int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // 每批读取数量，为 0 时使用默认值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamUsersRequest) Reset() {
+	*x = StreamUsersRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamUsersRequest) ProtoMessage() {}
+
+func (x *StreamUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamUsersRequest.ProtoReflect.Descriptor instead.
+func (*StreamUsersRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *StreamUsersRequest) GetFilter() *UserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *StreamUsersRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type PostFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Post 列表过滤条件，未设置的字段不参与过滤
+	Uuid          *ExactStringFilter `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	CreatedAt     *TimestampFilter   `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *TimestampFilter   `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Title         *StringFilter      `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Content       *StringFilter      `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostFilter) Reset() {
+	*x = PostFilter{}
+	mi := &file_dtos_gen_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostFilter) ProtoMessage() {}
+
+func (x *PostFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostFilter.ProtoReflect.Descriptor instead.
+func (*PostFilter) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PostFilter) GetUuid() *ExactStringFilter {
+	if x != nil {
+		return x.Uuid
+	}
+	return nil
+}
+
+func (x *PostFilter) GetCreatedAt() *TimestampFilter {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PostFilter) GetUpdatedAt() *TimestampFilter {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PostFilter) GetTitle() *StringFilter {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *PostFilter) GetContent() *StringFilter {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ListUserPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceName  string                 `protobuf:"bytes,1,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"` // 资源名称，格式为 users/{user}
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`            // 每页数量，为 0 时使用默认值
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`          // 上一页返回的 next_page_token，为空时从第一页开始
+	Filter        *PostFilter            `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy       PostOrderBy            `protobuf:"varint,5,opt,name=order_by,json=orderBy,proto3,enum=user.v1.PostOrderBy" json:"order_by,omitempty"` // 排序字段，未指定时按 ID 排序
+	Desc          bool                   `protobuf:"varint,6,opt,name=desc,proto3" json:"desc,omitempty"`                                               // 是否降序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserPostsRequest) Reset() {
+	*x = ListUserPostsRequest{}
+	mi := &file_dtos_gen_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPostsRequest) ProtoMessage() {}
+
+func (x *ListUserPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserPostsRequest) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListUserPostsRequest) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+func (x *ListUserPostsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUserPostsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUserPostsRequest) GetFilter() *PostFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListUserPostsRequest) GetOrderBy() PostOrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return PostOrderBy_POSTORDERBY_UNSPECIFIED
+}
+
+func (x *ListUserPostsRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+type ListUserPostsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 下一页的 page_token，为空时没有更多数据
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserPostsReply) Reset() {
+	*x = ListUserPostsReply{}
+	mi := &file_dtos_gen_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserPostsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPostsReply) ProtoMessage() {}
+
+func (x *ListUserPostsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dtos_gen_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPostsReply.ProtoReflect.Descriptor instead.
+func (*ListUserPostsReply) Descriptor() ([]byte, []int) {
+	return file_dtos_gen_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListUserPostsReply) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListUserPostsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_dtos_gen_proto protoreflect.FileDescriptor
+
+const file_dtos_gen_proto_rawDesc = "" +
+	"\n" +
+	"\x0edtos_gen.proto\x12\auser.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xc5\x02\n" +
+	"\x05Group\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
+	"\x04name\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x00R\x04name\x12\x1b\n" +
+	"\ttenant_id\x18\x05 \x01(\tR\btenantId\x122\n" +
+	"\rmoderator_ids\x18\x06 \x03(\tB\r\xfaB\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\fmoderatorIds\x12:\n" +
+	"\vmemberships\x18\a \x03(\v2\x18.user.v1.GroupMembershipR\vmemberships\"\x9a\x02\n" +
+	"\x0fGroupMembership\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x125\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x17.user.v1.MembershipRoleB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04role\x127\n" +
+	"\tjoined_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"\xd8\x02\n" +
+	"\n" +
+	"Membership\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x125\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x17.user.v1.MembershipRoleB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04role\x127\n" +
+	"\tjoined_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\x12!\n" +
+	"\auser_id\x18\x06 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12#\n" +
+	"\bgroup_id\x18\a \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\agroupId\"\xaf\x02\n" +
+	"\x04Post\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\x05title\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x00R\x05title\x12\"\n" +
+	"\acontent\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\x90NR\acontent\x12 \n" +
+	"\x06author\x18\x06 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06author:8\xeaA5\n" +
+	"\x18testenv.lazyent.dev/Post\x12\x19users/{user}/posts/{post}\"\xf8\x05\n" +
+	"\x04User\x12#\n" +
+	"\rresource_name\x18\x01 \x01(\tR\fresourceName\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x19\n" +
+	"\x03age\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x03age\x12(\n" +
+	"\bnickname\x18\x06 \x01(\tB\f\xfaB\tr\a\x10\x02\x18\x14\xd0\x01\x01R\bnickname\x12\x1d\n" +
+	"\n" +
+	"user_score\x18\a \x01(\rR\tuserScore\x12\x1f\n" +
+	"\vis_verified\x18\b \x01(\bR\n" +
+	"isVerified\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12%\n" +
+	"\ttest_uuid\x18\n" +
+	" \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\btestUuid\x126\n" +
+	"\x12test_nillable_uuid\x18\v \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x10testNillableUuid\x125\n" +
+	"\x06status\x18\f \x01(\x0e2\x13.user.v1.UserStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\x12\x12\n" +
+	"\x04role\x18\r \x01(\tR\x04role\x12\x18\n" +
+	"\aversion\x18\x0e \x01(\x05R\aversion\x12(\n" +
+	"\bpost_ids\x18\x0f \x03(\tB\r\xfaB\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\apostIds\x12*\n" +
+	"\tgroup_ids\x18\x10 \x03(\tB\r\xfaB\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\bgroupIds\x12\x1d\n" +
+	"\n" +
+	"post_count\x18\x11 \x01(\x03R\tpostCount\x12\x1f\n" +
+	"\vgroup_count\x18\x12 \x01(\x03R\n" +
+	"groupCount\x12!\n" +
+	"\ffriend_count\x18\x13 \x01(\x03R\vfriendCount:+\xeaA(\n" +
+	"\x18testenv.lazyent.dev/User\x12\fusers/{user}\"e\n" +
+	"\x12CreateGroupRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x00R\x04name\x122\n" +
+	"\rmoderator_ids\x18\x02 \x03(\tB\r\xfaB\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\fmoderatorIds\"8\n" +
+	"\x10CreateGroupReply\x12$\n" +
+	"\x05group\x18\x01 \x01(\v2\x0e.user.v1.GroupR\x05group\"/\n" +
+	"\x0fGetGroupRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\"5\n" +
+	"\rGetGroupReply\x12$\n" +
+	"\x05group\x18\x01 \x01(\v2\x0e.user.v1.GroupR\x05group\"4\n" +
+	"\x15GetGroupByNameRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x00R\x04name\";\n" +
+	"\x13GetGroupByNameReply\x12$\n" +
+	"\x05group\x18\x01 \x01(\v2\x0e.user.v1.GroupR\x05group\"\xce\x01\n" +
+	"\x12UpdateGroupRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\x12 \n" +
+	"\x04name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x00H\x00R\x04name\x88\x01\x01\x122\n" +
+	"\rmoderator_ids\x18\x03 \x03(\tB\r\xfaB\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\fmoderatorIds\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMaskB\a\n" +
+	"\x05_name\"8\n" +
+	"\x10UpdateGroupReply\x12$\n" +
+	"\x05group\x18\x01 \x01(\v2\x0e.user.v1.GroupR\x05group\"2\n" +
+	"\x12DeleteGroupRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\"\x12\n" +
+	"\x10DeleteGroupReply\"?\n" +
+	"\x11ExactStringFilter\x12\x13\n" +
+	"\x02eq\x18\x01 \x01(\tH\x00R\x02eq\x88\x01\x01\x12\x0e\n" +
+	"\x02in\x18\x02 \x03(\tR\x02inB\x05\n" +
+	"\x03_eq\"\x9d\x02\n" +
+	"\x0fTimestampFilter\x12*\n" +
+	"\x02eq\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x02eq\x12*\n" +
+	"\x02in\x18\x02 \x03(\v2\x1a.google.protobuf.TimestampR\x02in\x12*\n" +
+	"\x02gt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02gt\x12,\n" +
+	"\x03gte\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x03gte\x12*\n" +
+	"\x02lt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02lt\x12,\n" +
+	"\x03lte\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x03lte\"h\n" +
+	"\fStringFilter\x12\x13\n" +
+	"\x02eq\x18\x01 \x01(\tH\x00R\x02eq\x88\x01\x01\x12\x0e\n" +
+	"\x02in\x18\x02 \x03(\tR\x02in\x12\x1f\n" +
+	"\bcontains\x18\x03 \x01(\tH\x01R\bcontains\x88\x01\x01B\x05\n" +
+	"\x03_eqB\v\n" +
+	"\t_contains\"\xda\x01\n" +
+	"\vGroupFilter\x12.\n" +
+	"\x04uuid\x18\x01 \x01(\v2\x1a.user.v1.ExactStringFilterR\x04uuid\x127\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x18.user.v1.TimestampFilterR\tcreatedAt\x127\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x18.user.v1.TimestampFilterR\tupdatedAt\x12)\n" +
+	"\x04name\x18\x04 \x01(\v2\x15.user.v1.StringFilterR\x04name\"\xc3\x01\n" +
+	"\x11ListGroupsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12,\n" +
+	"\x06filter\x18\x03 \x01(\v2\x14.user.v1.GroupFilterR\x06filter\x120\n" +
+	"\border_by\x18\x04 \x01(\x0e2\x15.user.v1.GroupOrderByR\aorderBy\x12\x12\n" +
+	"\x04desc\x18\x05 \x01(\bR\x04desc\"a\n" +
+	"\x0fListGroupsReply\x12&\n" +
+	"\x06groups\x18\x01 \x03(\v2\x0e.user.v1.GroupR\x06groups\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xaf\x01\n" +
+	"\vInt32Filter\x12\x13\n" +
+	"\x02eq\x18\x01 \x01(\x05H\x00R\x02eq\x88\x01\x01\x12\x0e\n" +
+	"\x02in\x18\x02 \x03(\x05R\x02in\x12\x13\n" +
+	"\x02gt\x18\x03 \x01(\x05H\x01R\x02gt\x88\x01\x01\x12\x15\n" +
+	"\x03gte\x18\x04 \x01(\x05H\x02R\x03gte\x88\x01\x01\x12\x13\n" +
+	"\x02lt\x18\x05 \x01(\x05H\x03R\x02lt\x88\x01\x01\x12\x15\n" +
+	"\x03lte\x18\x06 \x01(\x05H\x04R\x03lte\x88\x01\x01B\x05\n" +
+	"\x03_eqB\x05\n" +
+	"\x03_gtB\x06\n" +
+	"\x04_gteB\x05\n" +
+	"\x03_ltB\x06\n" +
+	"\x04_lte\"\xb0\x01\n" +
+	"\fUint32Filter\x12\x13\n" +
+	"\x02eq\x18\x01 \x01(\rH\x00R\x02eq\x88\x01\x01\x12\x0e\n" +
+	"\x02in\x18\x02 \x03(\rR\x02in\x12\x13\n" +
+	"\x02gt\x18\x03 \x01(\rH\x01R\x02gt\x88\x01\x01\x12\x15\n" +
+	"\x03gte\x18\x04 \x01(\rH\x02R\x03gte\x88\x01\x01\x12\x13\n" +
+	"\x02lt\x18\x05 \x01(\rH\x03R\x02lt\x88\x01\x01\x12\x15\n" +
+	"\x03lte\x18\x06 \x01(\rH\x04R\x03lte\x88\x01\x01B\x05\n" +
+	"\x03_eqB\x05\n" +
+	"\x03_gtB\x06\n" +
+	"\x04_gteB\x05\n" +
+	"\x03_ltB\x06\n" +
+	"\x04_lte\"(\n" +
+	"\n" +
+	"BoolFilter\x12\x13\n" +
+	"\x02eq\x18\x01 \x01(\bH\x00R\x02eq\x88\x01\x01B\x05\n" +
+	"\x03_eq\"h\n" +
+	"\x10UserStatusFilter\x12(\n" +
+	"\x02eq\x18\x01 \x01(\x0e2\x13.user.v1.UserStatusH\x00R\x02eq\x88\x01\x01\x12#\n" +
+	"\x02in\x18\x02 \x03(\x0e2\x13.user.v1.UserStatusR\x02inB\x05\n" +
+	"\x03_eq\"\xb6\x05\n" +
+	"\n" +
+	"UserFilter\x12.\n" +
+	"\x04uuid\x18\x01 \x01(\v2\x1a.user.v1.ExactStringFilterR\x04uuid\x127\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x18.user.v1.TimestampFilterR\tcreatedAt\x127\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x18.user.v1.TimestampFilterR\tupdatedAt\x12)\n" +
+	"\x04name\x18\x04 \x01(\v2\x15.user.v1.StringFilterR\x04name\x12&\n" +
+	"\x03age\x18\x05 \x01(\v2\x14.user.v1.Int32FilterR\x03age\x121\n" +
+	"\bnickname\x18\x06 \x01(\v2\x15.user.v1.StringFilterR\bnickname\x124\n" +
+	"\n" +
+	"user_score\x18\a \x01(\v2\x15.user.v1.Uint32FilterR\tuserScore\x124\n" +
+	"\vis_verified\x18\b \x01(\v2\x13.user.v1.BoolFilterR\n" +
+	"isVerified\x127\n" +
+	"\ttest_uuid\x18\t \x01(\v2\x1a.user.v1.ExactStringFilterR\btestUuid\x12H\n" +
+	"\x12test_nillable_uuid\x18\n" +
+	" \x01(\v2\x1a.user.v1.ExactStringFilterR\x10testNillableUuid\x121\n" +
+	"\x06status\x18\v \x01(\v2\x19.user.v1.UserStatusFilterR\x06status\x12.\n" +
+	"\x04role\x18\f \x01(\v2\x1a.user.v1.ExactStringFilterR\x04role\x12.\n" +
+	"\aversion\x18\r \x01(\v2\x14.user.v1.Int32FilterR\aversion\"\x8c\x02\n" +
+	"\x15ListGroupUsersRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12+\n" +
+	"\x06filter\x18\x04 \x01(\v2\x13.user.v1.UserFilterR\x06filter\x12/\n" +
+	"\border_by\x18\x05 \x01(\x0e2\x14.user.v1.UserOrderByR\aorderBy\x12\x12\n" +
+	"\x04desc\x18\x06 \x01(\bR\x04desc\x12'\n" +
+	"\x0finclude_deleted\x18\a \x01(\bR\x0eincludeDeleted\"b\n" +
+	"\x13ListGroupUsersReply\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"^\n" +
+	"\x14AddGroupUsersRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\x12(\n" +
+	"\buser_ids\x18\x02 \x03(\tB\r\xfaB\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\auserIds\"\x14\n" +
+	"\x12AddGroupUsersReply\"a\n" +
+	"\x17RemoveGroupUsersRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\x12(\n" +
+	"\buser_ids\x18\x02 \x03(\tB\r\xfaB\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\auserIds\"\x17\n" +
+	"\x15RemoveGroupUsersReply\"\x8c\x04\n" +
+	"\x11CreateUserRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\x03age\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x03age\x12-\n" +
+	"\bnickname\x18\x03 \x01(\tB\f\xfaB\tr\a\x10\x02\x18\x14\xd0\x01\x01H\x00R\bnickname\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"user_score\x18\x04 \x01(\rH\x01R\tuserScore\x88\x01\x01\x12$\n" +
+	"\vis_verified\x18\x05 \x01(\bH\x02R\n" +
+	"isVerified\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12*\n" +
+	"\ttest_uuid\x18\a \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x03R\btestUuid\x88\x01\x01\x12;\n" +
+	"\x12test_nillable_uuid\x18\b \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x04R\x10testNillableUuid\x88\x01\x01\x125\n" +
+	"\x06status\x18\t \x01(\x0e2\x13.user.v1.UserStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\x12\x17\n" +
+	"\x04role\x18\n" +
+	" \x01(\tH\x05R\x04role\x88\x01\x01\x12(\n" +
+	"\bpost_ids\x18\v \x03(\tB\r\xfaB\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\apostIdsB\v\n" +
+	"\t_nicknameB\r\n" +
+	"\v_user_scoreB\x0e\n" +
+	"\f_is_verifiedB\f\n" +
+	"\n" +
+	"_test_uuidB\x15\n" +
+	"\x13_test_nillable_uuidB\a\n" +
+	"\x05_role\"4\n" +
+	"\x0fCreateUserReply\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"5\n" +
+	"\x0eGetUserRequest\x12#\n" +
+	"\rresource_name\x18\x01 \x01(\tR\fresourceName\"1\n" +
+	"\fGetUserReply\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"K\n" +
+	"\x1aGetUserByNameAndAgeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\x03age\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x03age\"=\n" +
+	"\x18GetUserByNameAndAgeReply\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"\x9a\x05\n" +
+	"\x11UpdateUserRequest\x12#\n" +
+	"\rresource_name\x18\x01 \x01(\tR\fresourceName\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1e\n" +
+	"\x03age\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00H\x01R\x03age\x88\x01\x01\x12-\n" +
+	"\bnickname\x18\x04 \x01(\tB\f\xfaB\tr\a\x10\x02\x18\x14\xd0\x01\x01H\x02R\bnickname\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"user_score\x18\x05 \x01(\rH\x03R\tuserScore\x88\x01\x01\x12$\n" +
+	"\vis_verified\x18\x06 \x01(\bH\x04R\n" +
+	"isVerified\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12*\n" +
+	"\ttest_uuid\x18\b \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x05R\btestUuid\x88\x01\x01\x12;\n" +
+	"\x12test_nillable_uuid\x18\t \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x06R\x10testNillableUuid\x88\x01\x01\x12:\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x13.user.v1.UserStatusB\b\xfaB\x05\x82\x01\x02\x10\x01H\aR\x06status\x88\x01\x01\x12\x17\n" +
+	"\x04role\x18\v \x01(\tH\bR\x04role\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\f \x01(\x05H\tR\aversion\x88\x01\x01\x12;\n" +
+	"\vupdate_mask\x18\r \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMaskB\a\n" +
+	"\x05_nameB\x06\n" +
+	"\x04_ageB\v\n" +
+	"\t_nicknameB\r\n" +
+	"\v_user_scoreB\x0e\n" +
+	"\f_is_verifiedB\f\n" +
+	"\n" +
+	"_test_uuidB\x15\n" +
+	"\x13_test_nillable_uuidB\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_roleB\n" +
+	"\n" +
+	"\b_version\"4\n" +
+	"\x0fUpdateUserReply\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"8\n" +
+	"\x11DeleteUserRequest\x12#\n" +
+	"\rresource_name\x18\x01 \x01(\tR\fresourceName\"\x11\n" +
+	"\x0fDeleteUserReply\"\xe9\x01\n" +
+	"\x10ListUsersRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12+\n" +
+	"\x06filter\x18\x03 \x01(\v2\x13.user.v1.UserFilterR\x06filter\x12/\n" +
+	"\border_by\x18\x04 \x01(\x0e2\x14.user.v1.UserOrderByR\aorderBy\x12\x12\n" +
+	"\x04desc\x18\x05 \x01(\bR\x04desc\x12'\n" +
+	"\x0finclude_deleted\x18\x06 \x01(\bR\x0eincludeDeleted\"]\n" +
+	"\x0eListUsersReply\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"9\n" +
+	"\x12RestoreUserRequest\x12#\n" +
+	"\rresource_name\x18\x01 \x01(\tR\fresourceName\"5\n" +
+	"\x10RestoreUserReply\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"`\n" +
+	"\x12StreamUsersRequest\x12+\n" +
+	"\x06filter\x18\x01 \x01(\v2\x13.user.v1.UserFilterR\x06filter\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\"\x8c\x02\n" +
+	"\n" +
+	"PostFilter\x12.\n" +
+	"\x04uuid\x18\x01 \x01(\v2\x1a.user.v1.ExactStringFilterR\x04uuid\x127\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x18.user.v1.TimestampFilterR\tcreatedAt\x127\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x18.user.v1.TimestampFilterR\tupdatedAt\x12+\n" +
+	"\x05title\x18\x04 \x01(\v2\x15.user.v1.StringFilterR\x05title\x12/\n" +
+	"\acontent\x18\x05 \x01(\v2\x15.user.v1.StringFilterR\acontent\"\xe9\x01\n" +
+	"\x14ListUserPostsRequest\x12#\n" +
+	"\rresource_name\x18\x01 \x01(\tR\fresourceName\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12+\n" +
+	"\x06filter\x18\x04 \x01(\v2\x13.user.v1.PostFilterR\x06filter\x12/\n" +
+	"\border_by\x18\x05 \x01(\x0e2\x14.user.v1.PostOrderByR\aorderBy\x12\x12\n" +
+	"\x04desc\x18\x06 \x01(\bR\x04desc\"a\n" +
+	"\x12ListUserPostsReply\x12#\n" +
+	"\x05posts\x18\x01 \x03(\v2\r.user.v1.PostR\x05posts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken*e\n" +
+	"\x0eMembershipRole\x12\x1e\n" +
+	"\x1aMEMBERSHIPROLE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15MEMBERSHIPROLE_MEMBER\x10\x01\x12\x18\n" +
+	"\x14MEMBERSHIPROLE_OWNER\x10\x02*o\n" +
+	"\n" +
+	"UserStatus\x12\x1a\n" +
+	"\x16USERSTATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11USERSTATUS_ACTIVE\x10\x01\x12\x17\n" +
+	"\x13USERSTATUS_INACTIVE\x10\x02\x12\x15\n" +
+	"\x11USERSTATUS_BANNED\x10\x03*}\n" +
+	"\fGroupOrderBy\x12\x1c\n" +
+	"\x18GROUPORDERBY_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17GROUPORDERBY_CREATED_AT\x10\x01\x12\x1b\n" +
+	"\x17GROUPORDERBY_UPDATED_AT\x10\x02\x12\x15\n" +
+	"\x11GROUPORDERBY_NAME\x10\x03*\xa6\x01\n" +
+	"\vUserOrderBy\x12\x1b\n" +
+	"\x17USERORDERBY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16USERORDERBY_CREATED_AT\x10\x01\x12\x1a\n" +
+	"\x16USERORDERBY_UPDATED_AT\x10\x02\x12\x14\n" +
+	"\x10USERORDERBY_NAME\x10\x03\x12\x13\n" +
+	"\x0fUSERORDERBY_AGE\x10\x04\x12\x17\n" +
+	"\x13USERORDERBY_VERSION\x10\x05*\x92\x01\n" +
+	"\vPostOrderBy\x12\x1b\n" +
+	"\x17POSTORDERBY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16POSTORDERBY_CREATED_AT\x10\x01\x12\x1a\n" +
+	"\x16POSTORDERBY_UPDATED_AT\x10\x02\x12\x15\n" +
+	"\x11POSTORDERBY_TITLE\x10\x03\x12\x17\n" +
+	"\x13POSTORDERBY_CONTENT\x10\x042\xbe\a\n" +
+	"\fGroupService\x12\\\n" +
+	"\vCreateGroup\x12\x1b.user.v1.CreateGroupRequest\x1a\x19.user.v1.CreateGroupReply\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/groups\x12W\n" +
+	"\bGetGroup\x12\x18.user.v1.GetGroupRequest\x1a\x16.user.v1.GetGroupReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/groups/{uuid}\x12q\n" +
+	"\x0eGetGroupByName\x12\x1e.user.v1.GetGroupByNameRequest\x1a\x1c.user.v1.GetGroupByNameReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/groups/by_name/{name}\x12c\n" +
+	"\vUpdateGroup\x12\x1b.user.v1.UpdateGroupRequest\x1a\x19.user.v1.UpdateGroupReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/v1/groups/{uuid}\x12`\n" +
+	"\vDeleteGroup\x12\x1b.user.v1.DeleteGroupRequest\x1a\x19.user.v1.DeleteGroupReply\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/groups/{uuid}\x12V\n" +
+	"\n" +
+	"ListGroups\x12\x1a.user.v1.ListGroupsRequest\x1a\x18.user.v1.ListGroupsReply\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/groups\x12o\n" +
+	"\x0eListGroupUsers\x12\x1e.user.v1.ListGroupUsersRequest\x1a\x1c.user.v1.ListGroupUsersReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/groups/{uuid}/users\x12s\n" +
+	"\rAddGroupUsers\x12\x1d.user.v1.AddGroupUsersRequest\x1a\x1b.user.v1.AddGroupUsersReply\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/groups/{uuid}/users:add\x12\x7f\n" +
+	"\x10RemoveGroupUsers\x12 .user.v1.RemoveGroupUsersRequest\x1a\x1e.user.v1.RemoveGroupUsersReply\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/groups/{uuid}/users:remove2\x9f\a\n" +
+	"\vUserService\x12X\n" +
+	"\n" +
+	"CreateUser\x12\x1a.user.v1.CreateUserRequest\x1a\x18.user.v1.CreateUserReply\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/users\x12^\n" +
+	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x15.user.v1.GetUserReply\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/{resource_name=users/*}\x12\x80\x01\n" +
+	"\x13GetUserByNameAndAge\x12#.user.v1.GetUserByNameAndAgeRequest\x1a!.user.v1.GetUserByNameAndAgeReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/users/by_name_and_age\x12j\n" +
+	"\n" +
+	"UpdateUser\x12\x1a.user.v1.UpdateUserRequest\x1a\x18.user.v1.UpdateUserReply\"&\x82\xd3\xe4\x93\x02 :\x01*\x1a\x1b/v1/{resource_name=users/*}\x12g\n" +
+	"\n" +
+	"DeleteUser\x12\x1a.user.v1.DeleteUserRequest\x1a\x18.user.v1.DeleteUserReply\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/{resource_name=users/*}\x12R\n" +
+	"\tListUsers\x12\x19.user.v1.ListUsersRequest\x1a\x17.user.v1.ListUsersReply\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/users\x12u\n" +
+	"\vRestoreUser\x12\x1b.user.v1.RestoreUserRequest\x1a\x19.user.v1.RestoreUserReply\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/{resource_name=users/*}:restore\x12;\n" +
+	"\vStreamUsers\x12\x1b.user.v1.StreamUsersRequest\x1a\r.user.v1.User0\x01\x12v\n" +
+	"\rListUserPosts\x12\x1d.user.v1.ListUserPostsRequest\x1a\x1b.user.v1.ListUserPostsReply\")\x82\xd3\xe4\x93\x02#\x12!/v1/{resource_name=users/*}/postsB\x1dZ\x1blazyent-test-app/user/v1;v1b\x06proto3"
+
+var (
+	file_dtos_gen_proto_rawDescOnce sync.Once
+	file_dtos_gen_proto_rawDescData []byte
+)
+
+func file_dtos_gen_proto_rawDescGZIP() []byte {
+	file_dtos_gen_proto_rawDescOnce.Do(func() {
+		file_dtos_gen_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dtos_gen_proto_rawDesc), len(file_dtos_gen_proto_rawDesc)))
+	})
+	return file_dtos_gen_proto_rawDescData
+}
+
+var file_dtos_gen_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_dtos_gen_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_dtos_gen_proto_goTypes = []any{
+	(MembershipRole)(0),                // 0: user.v1.MembershipRole
+	(UserStatus)(0),                    // 1: user.v1.UserStatus
+	(GroupOrderBy)(0),                  // 2: user.v1.GroupOrderBy
+	(UserOrderBy)(0),                   // 3: user.v1.UserOrderBy
+	(PostOrderBy)(0),                   // 4: user.v1.PostOrderBy
+	(*Group)(nil),                      // 5: user.v1.Group
+	(*GroupMembership)(nil),            // 6: user.v1.GroupMembership
+	(*Membership)(nil),                 // 7: user.v1.Membership
+	(*Post)(nil),                       // 8: user.v1.Post
+	(*User)(nil),                       // 9: user.v1.User
+	(*CreateGroupRequest)(nil),         // 10: user.v1.CreateGroupRequest
+	(*CreateGroupReply)(nil),           // 11: user.v1.CreateGroupReply
+	(*GetGroupRequest)(nil),            // 12: user.v1.GetGroupRequest
+	(*GetGroupReply)(nil),              // 13: user.v1.GetGroupReply
+	(*GetGroupByNameRequest)(nil),      // 14: user.v1.GetGroupByNameRequest
+	(*GetGroupByNameReply)(nil),        // 15: user.v1.GetGroupByNameReply
+	(*UpdateGroupRequest)(nil),         // 16: user.v1.UpdateGroupRequest
+	(*UpdateGroupReply)(nil),           // 17: user.v1.UpdateGroupReply
+	(*DeleteGroupRequest)(nil),         // 18: user.v1.DeleteGroupRequest
+	(*DeleteGroupReply)(nil),           // 19: user.v1.DeleteGroupReply
+	(*ExactStringFilter)(nil),          // 20: user.v1.ExactStringFilter
+	(*TimestampFilter)(nil),            // 21: user.v1.TimestampFilter
+	(*StringFilter)(nil),               // 22: user.v1.StringFilter
+	(*GroupFilter)(nil),                // 23: user.v1.GroupFilter
+	(*ListGroupsRequest)(nil),          // 24: user.v1.ListGroupsRequest
+	(*ListGroupsReply)(nil),            // 25: user.v1.ListGroupsReply
+	(*Int32Filter)(nil),                // 26: user.v1.Int32Filter
+	(*Uint32Filter)(nil),               // 27: user.v1.Uint32Filter
+	(*BoolFilter)(nil),                 // 28: user.v1.BoolFilter
+	(*UserStatusFilter)(nil),           // 29: user.v1.UserStatusFilter
+	(*UserFilter)(nil),                 // 30: user.v1.UserFilter
+	(*ListGroupUsersRequest)(nil),      // 31: user.v1.ListGroupUsersRequest
+	(*ListGroupUsersReply)(nil),        // 32: user.v1.ListGroupUsersReply
+	(*AddGroupUsersRequest)(nil),       // 33: user.v1.AddGroupUsersRequest
+	(*AddGroupUsersReply)(nil),         // 34: user.v1.AddGroupUsersReply
+	(*RemoveGroupUsersRequest)(nil),    // 35: user.v1.RemoveGroupUsersRequest
+	(*RemoveGroupUsersReply)(nil),      // 36: user.v1.RemoveGroupUsersReply
+	(*CreateUserRequest)(nil),          // 37: user.v1.CreateUserRequest
+	(*CreateUserReply)(nil),            // 38: user.v1.CreateUserReply
+	(*GetUserRequest)(nil),             // 39: user.v1.GetUserRequest
+	(*GetUserReply)(nil),               // 40: user.v1.GetUserReply
+	(*GetUserByNameAndAgeRequest)(nil), // 41: user.v1.GetUserByNameAndAgeRequest
+	(*GetUserByNameAndAgeReply)(nil),   // 42: user.v1.GetUserByNameAndAgeReply
+	(*UpdateUserRequest)(nil),          // 43: user.v1.UpdateUserRequest
+	(*UpdateUserReply)(nil),            // 44: user.v1.UpdateUserReply
+	(*DeleteUserRequest)(nil),          // 45: user.v1.DeleteUserRequest
+	(*DeleteUserReply)(nil),            // 46: user.v1.DeleteUserReply
+	(*ListUsersRequest)(nil),           // 47: user.v1.ListUsersRequest
+	(*ListUsersReply)(nil),             // 48: user.v1.ListUsersReply
+	(*RestoreUserRequest)(nil),         // 49: user.v1.RestoreUserRequest
+	(*RestoreUserReply)(nil),           // 50: user.v1.RestoreUserReply
+	(*StreamUsersRequest)(nil),         // 51: user.v1.StreamUsersRequest
+	(*PostFilter)(nil),                 // 52: user.v1.PostFilter
+	(*ListUserPostsRequest)(nil),       // 53: user.v1.ListUserPostsRequest
+	(*ListUserPostsReply)(nil),         // 54: user.v1.ListUserPostsReply
+	(*timestamppb.Timestamp)(nil),      // 55: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),      // 56: google.protobuf.FieldMask
+}
+var file_dtos_gen_proto_depIdxs = []int32{
+	55, // 0: user.v1.Group.created_at:type_name -> google.protobuf.Timestamp
+	55, // 1: user.v1.Group.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 2: user.v1.Group.memberships:type_name -> user.v1.GroupMembership
+	9,  // 3: user.v1.GroupMembership.user:type_name -> user.v1.User
+	55, // 4: user.v1.GroupMembership.created_at:type_name -> google.protobuf.Timestamp
+	55, // 5: user.v1.GroupMembership.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: user.v1.GroupMembership.role:type_name -> user.v1.MembershipRole
+	55, // 7: user.v1.GroupMembership.joined_at:type_name -> google.protobuf.Timestamp
+	55, // 8: user.v1.Membership.created_at:type_name -> google.protobuf.Timestamp
+	55, // 9: user.v1.Membership.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 10: user.v1.Membership.role:type_name -> user.v1.MembershipRole
+	55, // 11: user.v1.Membership.joined_at:type_name -> google.protobuf.Timestamp
+	55, // 12: user.v1.Post.created_at:type_name -> google.protobuf.Timestamp
+	55, // 13: user.v1.Post.updated_at:type_name -> google.protobuf.Timestamp
+	55, // 14: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	55, // 15: user.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 16: user.v1.User.status:type_name -> user.v1.UserStatus
+	5,  // 17: user.v1.CreateGroupReply.group:type_name -> user.v1.Group
+	5,  // 18: user.v1.GetGroupReply.group:type_name -> user.v1.Group
+	5,  // 19: user.v1.GetGroupByNameReply.group:type_name -> user.v1.Group
+	56, // 20: user.v1.UpdateGroupRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 21: user.v1.UpdateGroupReply.group:type_name -> user.v1.Group
+	55, // 22: user.v1.TimestampFilter.eq:type_name -> google.protobuf.Timestamp
+	55, // 23: user.v1.TimestampFilter.in:type_name -> google.protobuf.Timestamp
+	55, // 24: user.v1.TimestampFilter.gt:type_name -> google.protobuf.Timestamp
+	55, // 25: user.v1.TimestampFilter.gte:type_name -> google.protobuf.Timestamp
+	55, // 26: user.v1.TimestampFilter.lt:type_name -> google.protobuf.Timestamp
+	55, // 27: user.v1.TimestampFilter.lte:type_name -> google.protobuf.Timestamp
+	20, // 28: user.v1.GroupFilter.uuid:type_name -> user.v1.ExactStringFilter
+	21, // 29: user.v1.GroupFilter.created_at:type_name -> user.v1.TimestampFilter
+	21, // 30: user.v1.GroupFilter.updated_at:type_name -> user.v1.TimestampFilter
+	22, // 31: user.v1.GroupFilter.name:type_name -> user.v1.StringFilter
+	23, // 32: user.v1.ListGroupsRequest.filter:type_name -> user.v1.GroupFilter
+	2,  // 33: user.v1.ListGroupsRequest.order_by:type_name -> user.v1.GroupOrderBy
+	5,  // 34: user.v1.ListGroupsReply.groups:type_name -> user.v1.Group
+	1,  // 35: user.v1.UserStatusFilter.eq:type_name -> user.v1.UserStatus
+	1,  // 36: user.v1.UserStatusFilter.in:type_name -> user.v1.UserStatus
+	20, // 37: user.v1.UserFilter.uuid:type_name -> user.v1.ExactStringFilter
+	21, // 38: user.v1.UserFilter.created_at:type_name -> user.v1.TimestampFilter
+	21, // 39: user.v1.UserFilter.updated_at:type_name -> user.v1.TimestampFilter
+	22, // 40: user.v1.UserFilter.name:type_name -> user.v1.StringFilter
+	26, // 41: user.v1.UserFilter.age:type_name -> user.v1.Int32Filter
+	22, // 42: user.v1.UserFilter.nickname:type_name -> user.v1.StringFilter
+	27, // 43: user.v1.UserFilter.user_score:type_name -> user.v1.Uint32Filter
+	28, // 44: user.v1.UserFilter.is_verified:type_name -> user.v1.BoolFilter
+	20, // 45: user.v1.UserFilter.test_uuid:type_name -> user.v1.ExactStringFilter
+	20, // 46: user.v1.UserFilter.test_nillable_uuid:type_name -> user.v1.ExactStringFilter
+	29, // 47: user.v1.UserFilter.status:type_name -> user.v1.UserStatusFilter
+	20, // 48: user.v1.UserFilter.role:type_name -> user.v1.ExactStringFilter
+	26, // 49: user.v1.UserFilter.version:type_name -> user.v1.Int32Filter
+	30, // 50: user.v1.ListGroupUsersRequest.filter:type_name -> user.v1.UserFilter
+	3,  // 51: user.v1.ListGroupUsersRequest.order_by:type_name -> user.v1.UserOrderBy
+	9,  // 52: user.v1.ListGroupUsersReply.users:type_name -> user.v1.User
+	1,  // 53: user.v1.CreateUserRequest.status:type_name -> user.v1.UserStatus
+	9,  // 54: user.v1.CreateUserReply.user:type_name -> user.v1.User
+	9,  // 55: user.v1.GetUserReply.user:type_name -> user.v1.User
+	9,  // 56: user.v1.GetUserByNameAndAgeReply.user:type_name -> user.v1.User
+	1,  // 57: user.v1.UpdateUserRequest.status:type_name -> user.v1.UserStatus
+	56, // 58: user.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	9,  // 59: user.v1.UpdateUserReply.user:type_name -> user.v1.User
+	30, // 60: user.v1.ListUsersRequest.filter:type_name -> user.v1.UserFilter
+	3,  // 61: user.v1.ListUsersRequest.order_by:type_name -> user.v1.UserOrderBy
+	9,  // 62: user.v1.ListUsersReply.users:type_name -> user.v1.User
+	9,  // 63: user.v1.RestoreUserReply.user:type_name -> user.v1.User
+	30, // 64: user.v1.StreamUsersRequest.filter:type_name -> user.v1.UserFilter
+	20, // 65: user.v1.PostFilter.uuid:type_name -> user.v1.ExactStringFilter
+	21, // 66: user.v1.PostFilter.created_at:type_name -> user.v1.TimestampFilter
+	21, // 67: user.v1.PostFilter.updated_at:type_name -> user.v1.TimestampFilter
+	22, // 68: user.v1.PostFilter.title:type_name -> user.v1.StringFilter
+	22, // 69: user.v1.PostFilter.content:type_name -> user.v1.StringFilter
+	52, // 70: user.v1.ListUserPostsRequest.filter:type_name -> user.v1.PostFilter
+	4,  // 71: user.v1.ListUserPostsRequest.order_by:type_name -> user.v1.PostOrderBy
+	8,  // 72: user.v1.ListUserPostsReply.posts:type_name -> user.v1.Post
+	10, // 73: user.v1.GroupService.CreateGroup:input_type -> user.v1.CreateGroupRequest
+	12, // 74: user.v1.GroupService.GetGroup:input_type -> user.v1.GetGroupRequest
+	14, // 75: user.v1.GroupService.GetGroupByName:input_type -> user.v1.GetGroupByNameRequest
+	16, // 76: user.v1.GroupService.UpdateGroup:input_type -> user.v1.UpdateGroupRequest
+	18, // 77: user.v1.GroupService.DeleteGroup:input_type -> user.v1.DeleteGroupRequest
+	24, // 78: user.v1.GroupService.ListGroups:input_type -> user.v1.ListGroupsRequest
+	31, // 79: user.v1.GroupService.ListGroupUsers:input_type -> user.v1.ListGroupUsersRequest
+	33, // 80: user.v1.GroupService.AddGroupUsers:input_type -> user.v1.AddGroupUsersRequest
+	35, // 81: user.v1.GroupService.RemoveGroupUsers:input_type -> user.v1.RemoveGroupUsersRequest
+	37, // 82: user.v1.UserService.CreateUser:input_type -> user.v1.CreateUserRequest
+	39, // 83: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
+	41, // 84: user.v1.UserService.GetUserByNameAndAge:input_type -> user.v1.GetUserByNameAndAgeRequest
+	43, // 85: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
+	45, // 86: user.v1.UserService.DeleteUser:input_type -> user.v1.DeleteUserRequest
+	47, // 87: user.v1.UserService.ListUsers:input_type -> user.v1.ListUsersRequest
+	49, // 88: user.v1.UserService.RestoreUser:input_type -> user.v1.RestoreUserRequest
+	51, // 89: user.v1.UserService.StreamUsers:input_type -> user.v1.StreamUsersRequest
+	53, // 90: user.v1.UserService.ListUserPosts:input_type -> user.v1.ListUserPostsRequest
+	11, // 91: user.v1.GroupService.CreateGroup:output_type -> user.v1.CreateGroupReply
+	13, // 92: user.v1.GroupService.GetGroup:output_type -> user.v1.GetGroupReply
+	15, // 93: user.v1.GroupService.GetGroupByName:output_type -> user.v1.GetGroupByNameReply
+	17, // 94: user.v1.GroupService.UpdateGroup:output_type -> user.v1.UpdateGroupReply
+	19, // 95: user.v1.GroupService.DeleteGroup:output_type -> user.v1.DeleteGroupReply
+	25, // 96: user.v1.GroupService.ListGroups:output_type -> user.v1.ListGroupsReply
+	32, // 97: user.v1.GroupService.ListGroupUsers:output_type -> user.v1.ListGroupUsersReply
+	34, // 98: user.v1.GroupService.AddGroupUsers:output_type -> user.v1.AddGroupUsersReply
+	36, // 99: user.v1.GroupService.RemoveGroupUsers:output_type -> user.v1.RemoveGroupUsersReply
+	38, // 100: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserReply
+	40, // 101: user.v1.UserService.GetUser:output_type -> user.v1.GetUserReply
+	42, // 102: user.v1.UserService.GetUserByNameAndAge:output_type -> user.v1.GetUserByNameAndAgeReply
+	44, // 103: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserReply
+	46, // 104: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserReply
+	48, // 105: user.v1.UserService.ListUsers:output_type -> user.v1.ListUsersReply
+	50, // 106: user.v1.UserService.RestoreUser:output_type -> user.v1.RestoreUserReply
+	9,  // 107: user.v1.UserService.StreamUsers:output_type -> user.v1.User
+	54, // 108: user.v1.UserService.ListUserPosts:output_type -> user.v1.ListUserPostsReply
+	91, // [91:109] is the sub-list for method output_type
+	73, // [73:91] is the sub-list for method input_type
+	73, // [73:73] is the sub-list for extension type_name
+	73, // [73:73] is the sub-list for extension extendee
+	0,  // [0:73] is the sub-list for field type_name
+}
+
+func init() { file_dtos_gen_proto_init() }
+func file_dtos_gen_proto_init() {
+	if File_dtos_gen_proto != nil {
+		return
+	}
+	file_dtos_gen_proto_msgTypes[11].OneofWrappers = []any{}
+	file_dtos_gen_proto_msgTypes[15].OneofWrappers = []any{}
+	file_dtos_gen_proto_msgTypes[17].OneofWrappers = []any{}
+	file_dtos_gen_proto_msgTypes[21].OneofWrappers = []any{}
+	file_dtos_gen_proto_msgTypes[22].OneofWrappers = []any{}
+	file_dtos_gen_proto_msgTypes[23].OneofWrappers = []any{}
+	file_dtos_gen_proto_msgTypes[24].OneofWrappers = []any{}
+	file_dtos_gen_proto_msgTypes[32].OneofWrappers = []any{}
+	file_dtos_gen_proto_msgTypes[38].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dtos_gen_proto_rawDesc), len(file_dtos_gen_proto_rawDesc)),
+			NumEnums:      5,
+			NumMessages:   50,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_dtos_gen_proto_goTypes,
+		DependencyIndexes: file_dtos_gen_proto_depIdxs,
+		EnumInfos:         file_dtos_gen_proto_enumTypes,
+		MessageInfos:      file_dtos_gen_proto_msgTypes,
+	}.Build()
+	File_dtos_gen_proto = out.File
+	file_dtos_gen_proto_goTypes = nil
+	file_dtos_gen_proto_depIdxs = nil
+}
@@ -0,0 +1,194 @@
+// Code generated by lazyent. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: errors_gen.proto
+
+package v1
+
+import (
+	_ "github.com/go-kratos/kratos/v2/errors"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ErrorReason 是 Kratos 错误原因，errors.code 为对应的 HTTP 状态码
+type ErrorReason int32
+
+const (
+	ErrorReason_ERROR_REASON_UNSPECIFIED ErrorReason = 0
+	// Group
+	ErrorReason_GROUP_NOT_FOUND                   ErrorReason = 1 // Group 不存在
+	ErrorReason_GROUP_CONFLICT                    ErrorReason = 2 // 写入 Group 时违反了完整性约束
+	ErrorReason_GROUP_INVALID_ARGUMENT            ErrorReason = 3 // Group 的字段未通过校验
+	ErrorReason_GROUP_TENANT_ID_AND_NAME_CONFLICT ErrorReason = 4 // Group 的唯一键 (tenant_id, name) 已存在
+	// Membership
+	ErrorReason_MEMBERSHIP_NOT_FOUND                     ErrorReason = 5 // Membership 不存在
+	ErrorReason_MEMBERSHIP_CONFLICT                      ErrorReason = 6 // 写入 Membership 时违反了完整性约束
+	ErrorReason_MEMBERSHIP_INVALID_ARGUMENT              ErrorReason = 7 // Membership 的字段未通过校验
+	ErrorReason_MEMBERSHIP_USER_ID_AND_GROUP_ID_CONFLICT ErrorReason = 8 // Membership 的唯一键 (user_id, group_id) 已存在
+	// Post
+	ErrorReason_POST_NOT_FOUND        ErrorReason = 9  // Post 不存在
+	ErrorReason_POST_CONFLICT         ErrorReason = 10 // 写入 Post 时违反了完整性约束
+	ErrorReason_POST_INVALID_ARGUMENT ErrorReason = 11 // Post 的字段未通过校验
+	// User
+	ErrorReason_USER_NOT_FOUND               ErrorReason = 12 // User 不存在
+	ErrorReason_USER_CONFLICT                ErrorReason = 13 // 写入 User 时违反了完整性约束
+	ErrorReason_USER_INVALID_ARGUMENT        ErrorReason = 14 // User 的字段未通过校验
+	ErrorReason_USER_NAME_AND_AGE_CONFLICT   ErrorReason = 15 // User 的唯一键 (name, age) 已存在
+	ErrorReason_USER_CONCURRENT_MODIFICATION ErrorReason = 16 // User 已被并发修改，版本不匹配
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "ERROR_REASON_UNSPECIFIED",
+		1:  "GROUP_NOT_FOUND",
+		2:  "GROUP_CONFLICT",
+		3:  "GROUP_INVALID_ARGUMENT",
+		4:  "GROUP_TENANT_ID_AND_NAME_CONFLICT",
+		5:  "MEMBERSHIP_NOT_FOUND",
+		6:  "MEMBERSHIP_CONFLICT",
+		7:  "MEMBERSHIP_INVALID_ARGUMENT",
+		8:  "MEMBERSHIP_USER_ID_AND_GROUP_ID_CONFLICT",
+		9:  "POST_NOT_FOUND",
+		10: "POST_CONFLICT",
+		11: "POST_INVALID_ARGUMENT",
+		12: "USER_NOT_FOUND",
+		13: "USER_CONFLICT",
+		14: "USER_INVALID_ARGUMENT",
+		15: "USER_NAME_AND_AGE_CONFLICT",
+		16: "USER_CONCURRENT_MODIFICATION",
+	}
+	ErrorReason_value = map[string]int32{
+		"ERROR_REASON_UNSPECIFIED":                 0,
+		"GROUP_NOT_FOUND":                          1,
+		"GROUP_CONFLICT":                           2,
+		"GROUP_INVALID_ARGUMENT":                   3,
+		"GROUP_TENANT_ID_AND_NAME_CONFLICT":        4,
+		"MEMBERSHIP_NOT_FOUND":                     5,
+		"MEMBERSHIP_CONFLICT":                      6,
+		"MEMBERSHIP_INVALID_ARGUMENT":              7,
+		"MEMBERSHIP_USER_ID_AND_GROUP_ID_CONFLICT": 8,
+		"POST_NOT_FOUND":                           9,
+		"POST_CONFLICT":                            10,
+		"POST_INVALID_ARGUMENT":                    11,
+		"USER_NOT_FOUND":                           12,
+		"USER_CONFLICT":                            13,
+		"USER_INVALID_ARGUMENT":                    14,
+		"USER_NAME_AND_AGE_CONFLICT":               15,
+		"USER_CONCURRENT_MODIFICATION":             16,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_errors_gen_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_errors_gen_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_errors_gen_proto_rawDescGZIP(), []int{0}
+}
+
+var File_errors_gen_proto protoreflect.FileDescriptor
+
+const file_errors_gen_proto_rawDesc = "" +
+	"\n" +
+	"\x10errors_gen.proto\x12\auser.v1\x1a\x13errors/errors.proto*\xc5\x04\n" +
+	"\vErrorReason\x12\x1c\n" +
+	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x0fGROUP_NOT_FOUND\x10\x01\x1a\x04\xa8E\x94\x03\x12\x18\n" +
+	"\x0eGROUP_CONFLICT\x10\x02\x1a\x04\xa8E\x99\x03\x12 \n" +
+	"\x16GROUP_INVALID_ARGUMENT\x10\x03\x1a\x04\xa8E\x90\x03\x12+\n" +
+	"!GROUP_TENANT_ID_AND_NAME_CONFLICT\x10\x04\x1a\x04\xa8E\x99\x03\x12\x1e\n" +
+	"\x14MEMBERSHIP_NOT_FOUND\x10\x05\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
+	"\x13MEMBERSHIP_CONFLICT\x10\x06\x1a\x04\xa8E\x99\x03\x12%\n" +
+	"\x1bMEMBERSHIP_INVALID_ARGUMENT\x10\a\x1a\x04\xa8E\x90\x03\x122\n" +
+	"(MEMBERSHIP_USER_ID_AND_GROUP_ID_CONFLICT\x10\b\x1a\x04\xa8E\x99\x03\x12\x18\n" +
+	"\x0ePOST_NOT_FOUND\x10\t\x1a\x04\xa8E\x94\x03\x12\x17\n" +
+	"\rPOST_CONFLICT\x10\n" +
+	"\x1a\x04\xa8E\x99\x03\x12\x1f\n" +
+	"\x15POST_INVALID_ARGUMENT\x10\v\x1a\x04\xa8E\x90\x03\x12\x18\n" +
+	"\x0eUSER_NOT_FOUND\x10\f\x1a\x04\xa8E\x94\x03\x12\x17\n" +
+	"\rUSER_CONFLICT\x10\r\x1a\x04\xa8E\x99\x03\x12\x1f\n" +
+	"\x15USER_INVALID_ARGUMENT\x10\x0e\x1a\x04\xa8E\x90\x03\x12$\n" +
+	"\x1aUSER_NAME_AND_AGE_CONFLICT\x10\x0f\x1a\x04\xa8E\x99\x03\x12&\n" +
+	"\x1cUSER_CONCURRENT_MODIFICATION\x10\x10\x1a\x04\xa8E\x99\x03\x1a\x04\xa0E\xf4\x03B\x1dZ\x1blazyent-test-app/user/v1;v1b\x06proto3"
+
+var (
+	file_errors_gen_proto_rawDescOnce sync.Once
+	file_errors_gen_proto_rawDescData []byte
+)
+
+func file_errors_gen_proto_rawDescGZIP() []byte {
+	file_errors_gen_proto_rawDescOnce.Do(func() {
+		file_errors_gen_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_errors_gen_proto_rawDesc), len(file_errors_gen_proto_rawDesc)))
+	})
+	return file_errors_gen_proto_rawDescData
+}
+
+var file_errors_gen_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_errors_gen_proto_goTypes = []any{
+	(ErrorReason)(0), // 0: user.v1.ErrorReason
+}
+var file_errors_gen_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_errors_gen_proto_init() }
+func file_errors_gen_proto_init() {
+	if File_errors_gen_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_errors_gen_proto_rawDesc), len(file_errors_gen_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_errors_gen_proto_goTypes,
+		DependencyIndexes: file_errors_gen_proto_depIdxs,
+		EnumInfos:         file_errors_gen_proto_enumTypes,
+	}.Build()
+	File_errors_gen_proto = out.File
+	file_errors_gen_proto_goTypes = nil
+	file_errors_gen_proto_depIdxs = nil
+}
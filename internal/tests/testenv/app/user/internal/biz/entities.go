@@ -6,6 +6,11 @@ type Group struct {
 	GroupBase
 }
 
+// Membership 是业务实体，嵌入了生成的 Base 结构体。
+type Membership struct {
+	MembershipBase
+}
+
 // Post 是业务实体，嵌入了生成的 Base 结构体。
 type Post struct {
 	PostBase
@@ -7,13 +7,158 @@ import (
 	"github.com/Cromemadnd/lazyent/internal/tests/testenv/pkg/auth"
 )
 
+// StringFilter 是字符串字段的过滤条件，nil 或空的条件不参与过滤
+type StringFilter struct {
+	EQ       *string
+	In       []string
+	Contains *string
+}
+
+// BoolFilter 是布尔字段的过滤条件，nil 表示不参与过滤
+type BoolFilter struct {
+	EQ *bool
+}
+
+// ValueFilter 是按值匹配字段的过滤条件，nil 或空的条件不参与过滤
+type ValueFilter[T any] struct {
+	EQ *T
+	In []T
+}
+
+// RangeFilter 是可比较大小字段的过滤条件，nil 或空的条件不参与过滤
+type RangeFilter[T any] struct {
+	EQ  *T
+	In  []T
+	GT  *T
+	GTE *T
+	LT  *T
+	LTE *T
+}
+
 // GroupBase 是 Group 的基础结构体，包含自动生成的字段定义
 type GroupBase struct {
+	UUID        string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Name        string
+	TenantID    string
+	Moderators  []*User
+	Memberships []*GroupMembership
+
+	stub bool // 是否为仅包含 ID 的占位实体
+}
+
+// NewGroupStub 创建仅包含 ID 的 Group 占位实体 (例如由 ProtoID 策略的关联 ID 构造)
+func NewGroupStub(id string) *Group {
+	return &Group{GroupBase: GroupBase{UUID: id, stub: true}}
+}
+
+// IsStub 判断该实体是否为仅包含 ID 的占位实体，而非完整加载的实体
+func (b *GroupBase) IsStub() bool {
+	return b != nil && b.stub
+}
+
+// GroupPatch 是 Group 的部分更新，nil 字段表示不修改
+type GroupPatch struct {
+	UUID         string
+	Name         *string
+	ModeratorIDs []string
+}
+
+// ApplyTo 将 Patch 中已设置的字段写入 b
+func (p *GroupPatch) ApplyTo(b *Group) {
+	if p.Name != nil {
+		b.Name = *p.Name
+	}
+	if p.ModeratorIDs != nil {
+		b.Moderators = make([]*User, 0, len(p.ModeratorIDs))
+		for _, id := range p.ModeratorIDs {
+			b.Moderators = append(b.Moderators, NewUserStub(id))
+		}
+	}
+}
+
+// GroupFilter 是 Group 列表的过滤条件，nil 字段不参与过滤
+type GroupFilter struct {
+	UUID      *ValueFilter[string]
+	CreatedAt *RangeFilter[time.Time]
+	UpdatedAt *RangeFilter[time.Time]
+	Name      *StringFilter
+}
+
+// GroupOrderField 是 Group 列表的排序字段
+type GroupOrderField string
+
+const (
+	GroupOrderByID        GroupOrderField = "" // 按 ID 排序
+	GroupOrderByCreatedAt GroupOrderField = "created_at"
+	GroupOrderByUpdatedAt GroupOrderField = "updated_at"
+	GroupOrderByName      GroupOrderField = "name"
+)
+
+// GroupListOptions 是 Group 列表的分页、过滤与排序选项
+type GroupListOptions struct {
+	PageSize  int    // 每页数量，为 0 时使用默认值
+	PageToken string // 上一页返回的游标，为空时从第一页开始
+	Filter    *GroupFilter
+	OrderBy   GroupOrderField // 排序字段，相同值之间按 ID 排序
+	Desc      bool            // 是否降序
+}
+
+// GroupMembership 是 Group 经由 Membership 的关联实体，包含关联对象与关联表字段
+type GroupMembership struct {
+	User      *User
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Role      MembershipRole
+	JoinedAt  time.Time
+}
+
+// Role 枚举定义
+type MembershipRole int32
+
+const (
+	MembershipRoleUnspecified MembershipRole = 0
+	MembershipRoleMember      MembershipRole = 1
+	MembershipRoleOwner       MembershipRole = 2
+)
+
+func (e MembershipRole) String() string {
+	switch e {
+	case MembershipRoleUnspecified:
+		return "UNSPECIFIED"
+	case MembershipRoleMember:
+		return "MEMBER"
+	case MembershipRoleOwner:
+		return "OWNER"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// MembershipBase 是 Membership 的基础结构体，包含自动生成的字段定义
+type MembershipBase struct {
 	UUID      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
-	Name      string
-	Users     []*User
+	Role      MembershipRole
+	JoinedAt  time.Time
+	UserID    string
+	GroupID   string
+	User      *User
+	Group     *Group
+
+	stub bool // 是否为仅包含 ID 的占位实体
+}
+
+// NewMembershipStub 创建仅包含 ID 的 Membership 占位实体 (例如由 ProtoID 策略的关联 ID 构造)
+func NewMembershipStub(id string) *Membership {
+	return &Membership{MembershipBase: MembershipBase{UUID: id, stub: true}}
+}
+
+// IsStub 判断该实体是否为仅包含 ID 的占位实体，而非完整加载的实体
+func (b *MembershipBase) IsStub() bool {
+	return b != nil && b.stub
 }
 
 // PostBase 是 Post 的基础结构体，包含自动生成的字段定义
@@ -24,6 +169,47 @@ type PostBase struct {
 	Title     string
 	Content   string
 	Author    *User
+
+	stub bool // 是否为仅包含 ID 的占位实体
+}
+
+// NewPostStub 创建仅包含 ID 的 Post 占位实体 (例如由 ProtoID 策略的关联 ID 构造)
+func NewPostStub(id string) *Post {
+	return &Post{PostBase: PostBase{UUID: id, stub: true}}
+}
+
+// IsStub 判断该实体是否为仅包含 ID 的占位实体，而非完整加载的实体
+func (b *PostBase) IsStub() bool {
+	return b != nil && b.stub
+}
+
+// PostFilter 是 Post 列表的过滤条件，nil 字段不参与过滤
+type PostFilter struct {
+	UUID      *ValueFilter[string]
+	CreatedAt *RangeFilter[time.Time]
+	UpdatedAt *RangeFilter[time.Time]
+	Title     *StringFilter
+	Content   *StringFilter
+}
+
+// PostOrderField 是 Post 列表的排序字段
+type PostOrderField string
+
+const (
+	PostOrderByID        PostOrderField = "" // 按 ID 排序
+	PostOrderByCreatedAt PostOrderField = "created_at"
+	PostOrderByUpdatedAt PostOrderField = "updated_at"
+	PostOrderByTitle     PostOrderField = "title"
+	PostOrderByContent   PostOrderField = "content"
+)
+
+// PostListOptions 是 Post 列表的分页、过滤与排序选项
+type PostListOptions struct {
+	PageSize  int    // 每页数量，为 0 时使用默认值
+	PageToken string // 上一页返回的游标，为空时从第一页开始
+	Filter    *PostFilter
+	OrderBy   PostOrderField // 排序字段，相同值之间按 ID 排序
+	Desc      bool           // 是否降序
 }
 
 // Status 枚举定义
@@ -56,17 +242,139 @@ type UserBase struct {
 	UUID             string
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
+	DeletedAt        time.Time
 	Name             string
 	Age              int
 	Nickname         string
 	UserScore        uint8
 	IsVerified       bool
 	Tags             []string
+	Password         string
+	InternalNote     string
 	TestUUID         string
 	TestNillableUUID string
 	Status           UserStatus
 	Role             auth.UserRole
+	Version          int
 	PostIDs          []string
 	Groups           []*Group
 	Friends          []*User
+	PostCount        int
+	GroupCount       int
+	FriendCount      int
+
+	stub bool // 是否为仅包含 ID 的占位实体
+}
+
+// NewUserStub 创建仅包含 ID 的 User 占位实体 (例如由 ProtoID 策略的关联 ID 构造)
+func NewUserStub(id string) *User {
+	return &User{UserBase: UserBase{UUID: id, stub: true}}
+}
+
+// IsStub 判断该实体是否为仅包含 ID 的占位实体，而非完整加载的实体
+func (b *UserBase) IsStub() bool {
+	return b != nil && b.stub
+}
+
+// Redacted 返回 User 的浅拷贝，其中 Sensitive 字段被置为零值，可安全用于日志等输出
+func (b *User) Redacted() *User {
+	if b == nil {
+		return nil
+	}
+	c := *b
+	var zero UserBase
+	c.Password = zero.Password
+	return &c
+}
+
+// UserPatch 是 User 的部分更新，nil 字段表示不修改
+type UserPatch struct {
+	UUID             string
+	Name             *string
+	Age              *int
+	Nickname         *string
+	UserScore        *uint8
+	IsVerified       *bool
+	Tags             []string
+	TestUUID         *string
+	TestNillableUUID *string
+	Status           *UserStatus
+	Role             *auth.UserRole
+	Version          *int // 期望的当前版本，nil 时以读取到的版本为准
+}
+
+// ApplyTo 将 Patch 中已设置的字段写入 b
+func (p *UserPatch) ApplyTo(b *User) {
+	if p.Name != nil {
+		b.Name = *p.Name
+	}
+	if p.Age != nil {
+		b.Age = *p.Age
+	}
+	if p.Nickname != nil {
+		b.Nickname = *p.Nickname
+	}
+	if p.UserScore != nil {
+		b.UserScore = *p.UserScore
+	}
+	if p.IsVerified != nil {
+		b.IsVerified = *p.IsVerified
+	}
+	if p.Tags != nil {
+		b.Tags = p.Tags
+	}
+	if p.TestUUID != nil {
+		b.TestUUID = *p.TestUUID
+	}
+	if p.TestNillableUUID != nil {
+		b.TestNillableUUID = *p.TestNillableUUID
+	}
+	if p.Status != nil {
+		b.Status = *p.Status
+	}
+	if p.Role != nil {
+		b.Role = *p.Role
+	}
+	if p.Version != nil {
+		b.Version = *p.Version
+	}
+}
+
+// UserFilter 是 User 列表的过滤条件，nil 字段不参与过滤
+type UserFilter struct {
+	UUID             *ValueFilter[string]
+	CreatedAt        *RangeFilter[time.Time]
+	UpdatedAt        *RangeFilter[time.Time]
+	Name             *StringFilter
+	Age              *RangeFilter[int]
+	Nickname         *StringFilter
+	UserScore        *RangeFilter[uint8]
+	IsVerified       *BoolFilter
+	TestUUID         *ValueFilter[string]
+	TestNillableUUID *ValueFilter[string]
+	Status           *ValueFilter[UserStatus]
+	Role             *ValueFilter[auth.UserRole]
+	Version          *RangeFilter[int]
+}
+
+// UserOrderField 是 User 列表的排序字段
+type UserOrderField string
+
+const (
+	UserOrderByID        UserOrderField = "" // 按 ID 排序
+	UserOrderByCreatedAt UserOrderField = "created_at"
+	UserOrderByUpdatedAt UserOrderField = "updated_at"
+	UserOrderByName      UserOrderField = "name"
+	UserOrderByAge       UserOrderField = "age"
+	UserOrderByVersion   UserOrderField = "version"
+)
+
+// UserListOptions 是 User 列表的分页、过滤与排序选项
+type UserListOptions struct {
+	PageSize       int    // 每页数量，为 0 时使用默认值
+	PageToken      string // 上一页返回的游标，为空时从第一页开始
+	Filter         *UserFilter
+	OrderBy        UserOrderField // 排序字段，相同值之间按 ID 排序
+	Desc           bool           // 是否降序
+	IncludeDeleted bool           // 是否包含已软删除的 User
 }
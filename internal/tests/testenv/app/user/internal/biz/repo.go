@@ -0,0 +1,10 @@
+// 该文件仅生成一次，可以在此添加自定义仓储方法。
+package biz
+
+// GroupRepoExt 声明 GroupRepo 的手写扩展方法，由 Data 层一并实现。
+type GroupRepoExt interface {
+}
+
+// UserRepoExt 声明 UserRepo 的手写扩展方法，由 Data 层一并实现。
+type UserRepoExt interface {
+}
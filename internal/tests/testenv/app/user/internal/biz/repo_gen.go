@@ -0,0 +1,156 @@
+// Code generated by lazyent. DO NOT EDIT.
+package biz
+
+import (
+	"context"
+	"errors"
+	"iter"
+)
+
+var (
+	// ErrNotFound 表示实体不存在，仓储返回的 *NotFoundError 满足 errors.Is(err, ErrNotFound)
+	ErrNotFound = errors.New("not found")
+	// ErrConflict 表示违反唯一约束等完整性约束，仓储返回的 *ConflictError 满足 errors.Is(err, ErrConflict)
+	ErrConflict = errors.New("conflict")
+	// ErrConcurrentModification 表示实体已被并发修改 (版本不匹配)，仓储返回的 *ConcurrentModificationError 满足 errors.Is(err, ErrConcurrentModification)
+	ErrConcurrentModification = errors.New("concurrent modification")
+)
+
+// NotFoundError 表示 Entity 对应的实体不存在，Err 为 Data 层的原始错误
+type NotFoundError struct {
+	Entity string
+	Err    error
+}
+
+func (e *NotFoundError) Error() string {
+	return e.Entity + " not found"
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.Err
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// ConflictError 表示写入 Entity 时违反了唯一约束等完整性约束，Err 为 Data 层的原始错误
+type ConflictError struct {
+	Entity string
+	Err    error
+}
+
+func (e *ConflictError) Error() string {
+	return e.Entity + " conflict: " + e.Err.Error()
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// ConcurrentModificationError 表示更新 Entity 时其版本已被并发修改
+type ConcurrentModificationError struct {
+	Entity string
+}
+
+func (e *ConcurrentModificationError) Error() string {
+	return e.Entity + " concurrent modification"
+}
+
+func (e *ConcurrentModificationError) Is(target error) bool {
+	return target == ErrConcurrentModification
+}
+
+// ErrNoTenant 表示 ctx 中没有租户
+var ErrNoTenant = errors.New("no tenant")
+
+// TenantResolver 返回 ctx 所属租户的 ID，由应用提供并注入仓储的构造函数。带租户字段的仓储以其限定全部查询、更新与删除，
+// 并在创建时填充租户字段；ctx 中没有租户时应返回 ErrNoTenant，此时仍可读取不带租户字段的实体，但不加载带租户字段的关联实体
+type TenantResolver func(ctx context.Context) (string, error)
+
+// Transaction 由 Data 层实现，InTx 在同一事务中执行 fn，fn 中以其 ctx 调用的仓储方法共享该事务
+type Transaction interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// GroupRepo 是 Group 的仓储接口，由 Data 层实现
+// 手写的扩展方法在 GroupRepoExt 中声明
+type GroupRepo interface {
+	GroupRepoExt
+
+	// Save 创建 Group 并返回保存后的实体
+	Save(ctx context.Context, b *Group) (*Group, error)
+
+	// Update 按 mask 中的 Proto 字段路径更新 Group，mask 为空时更新全部可变字段
+	Update(ctx context.Context, b *Group, mask []string) (*Group, error)
+
+	// FindByID 按 ID 查询 Group
+	FindByID(ctx context.Context, id string) (*Group, error)
+
+	// Delete 按 ID 删除 Group
+	Delete(ctx context.Context, id string) error
+
+	// List 按 opts 分页列出 Group，同时返回下一页的游标 (没有更多数据时为空)
+	List(ctx context.Context, opts *GroupListOptions) ([]*Group, string, error)
+
+	// FindByName 按唯一键 (name) 查询 Group，不存在时返回 NotFoundError
+	FindByName(ctx context.Context, name string) (*Group, error)
+
+	// ListGroupModerators 列出 ID 为 id 的 Group 经由 moderators 关联的 User
+	ListGroupModerators(ctx context.Context, id string) ([]*User, error)
+
+	// ListGroupUsers 按 opts 分页列出 ID 为 id 的 Group 经由 users 关联的 User，同时返回下一页的游标
+	ListGroupUsers(ctx context.Context, id string, opts *UserListOptions) ([]*User, string, error)
+
+	// AddGroupUsers 为 ID 为 id 的 Group 添加 users 关联的 User
+	AddGroupUsers(ctx context.Context, id string, ids []string) error
+
+	// RemoveGroupUsers 为 ID 为 id 的 Group 移除 users 关联的 User
+	RemoveGroupUsers(ctx context.Context, id string, ids []string) error
+}
+
+// UserRepo 是 User 的仓储接口，由 Data 层实现
+// 手写的扩展方法在 UserRepoExt 中声明
+type UserRepo interface {
+	UserRepoExt
+
+	// Save 创建 User 并返回保存后的实体
+	Save(ctx context.Context, b *User) (*User, error)
+
+	// Update 按 mask 中的 Proto 字段路径更新 User，mask 为空时更新全部可变字段
+	Update(ctx context.Context, b *User, mask []string) (*User, error)
+
+	// FindByID 按 ID 查询 User
+	FindByID(ctx context.Context, id string) (*User, error)
+
+	// Delete 按 ID 软删除 User，已删除的 User 视为不存在
+	Delete(ctx context.Context, id string) error
+
+	// Restore 按 ID 恢复已软删除的 User，User 不存在或未被删除时返回 NotFoundError
+	Restore(ctx context.Context, id string) (*User, error)
+
+	// ListAll 列出全部 User
+	ListAll(ctx context.Context) ([]*User, error)
+
+	// List 按 opts 分页列出 User，同时返回下一页的游标 (没有更多数据时为空)
+	List(ctx context.Context, opts *UserListOptions) ([]*User, string, error)
+
+	// Stream 按 ID 顺序分批读取满足 filter 的 User，每批 batchSize 行 (0 时使用默认值)，迭代出错时产出 error 并结束
+	Stream(ctx context.Context, filter *UserFilter, batchSize int) iter.Seq2[*User, error]
+
+	// FindByNameAndAge 按唯一键 (name, age) 查询 User，不存在时返回 NotFoundError
+	FindByNameAndAge(ctx context.Context, name string, age int) (*User, error)
+
+	// ListUserGroups 列出 ID 为 id 的 User 经由 groups 关联的 Group
+	ListUserGroups(ctx context.Context, id string) ([]*Group, error)
+
+	// ListUserFriends 列出 ID 为 id 的 User 经由 friends 关联的 User
+	ListUserFriends(ctx context.Context, id string) ([]*User, error)
+
+	// ListUserPosts 按 opts 分页列出 ID 为 id 的 User 经由 posts 关联的 Post，同时返回下一页的游标
+	ListUserPosts(ctx context.Context, id string, opts *PostListOptions) ([]*Post, string, error)
+}
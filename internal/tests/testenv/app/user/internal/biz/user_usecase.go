@@ -0,0 +1,53 @@
+// 该文件仅生成一次，可以在此添加自定义业务逻辑。
+package biz
+
+import (
+	"context"
+)
+
+// UserUsecase 是 User 的业务用例，嵌入了生成的 UserUsecaseBase，可以在此覆盖其方法或添加新方法。
+type UserUsecase struct {
+	*UserUsecaseBase
+}
+
+// NewUserUsecase 创建 UserUsecase 并注册钩子。
+func NewUserUsecase(repo UserRepo, tx Transaction) *UserUsecase {
+	uc := &UserUsecase{UserUsecaseBase: NewUserUsecaseBase(repo, tx)}
+	uc.Hooks.BeforeCreate = uc.beforeCreate
+	uc.Hooks.AfterCreate = uc.afterCreate
+	uc.Hooks.BeforeUpdate = uc.beforeUpdate
+	uc.Hooks.AfterUpdate = uc.afterUpdate
+	uc.Hooks.BeforeDelete = uc.beforeDelete
+	uc.Hooks.AfterDelete = uc.afterDelete
+	return uc
+}
+
+// beforeCreate 在 b 通过校验后、保存前执行，返回错误时中止创建。
+func (uc *UserUsecase) beforeCreate(ctx context.Context, b *User) error {
+	return nil
+}
+
+// afterCreate 在 b 保存后、事务提交前执行，返回错误时回滚创建。
+func (uc *UserUsecase) afterCreate(ctx context.Context, b *User) error {
+	return nil
+}
+
+// beforeUpdate 在 b 应用更新并通过校验后、保存前执行，返回错误时中止更新。
+func (uc *UserUsecase) beforeUpdate(ctx context.Context, b *User, mask []string) error {
+	return nil
+}
+
+// afterUpdate 在 b 保存后、事务提交前执行，返回错误时回滚更新。
+func (uc *UserUsecase) afterUpdate(ctx context.Context, b *User) error {
+	return nil
+}
+
+// beforeDelete 在删除 ID 为 id 的 User 前执行，返回错误时中止删除。
+func (uc *UserUsecase) beforeDelete(ctx context.Context, id string) error {
+	return nil
+}
+
+// afterDelete 在删除后、事务提交前执行，返回错误时回滚删除。
+func (uc *UserUsecase) afterDelete(ctx context.Context, id string) error {
+	return nil
+}
@@ -2,34 +2,111 @@
 package data
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/biz"
 	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent/group"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent/membership"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent/post"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent/predicate"
 	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent/user"
 	"github.com/google/uuid"
+
+	pb "github.com/Cromemadnd/lazyent/internal/tests/testenv/api/v1"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+)
+
+// invalidArgumentReasons 按实体名索引参数无效时的 ErrorReason
+var invalidArgumentReasons = map[string]pb.ErrorReason{
+	"Group":      pb.ErrorReason_GROUP_INVALID_ARGUMENT,
+	"Membership": pb.ErrorReason_MEMBERSHIP_INVALID_ARGUMENT,
+	"Post":       pb.ErrorReason_POST_INVALID_ARGUMENT,
+	"User":       pb.ErrorReason_USER_INVALID_ARGUMENT,
+}
+
+// invalidArgument 将 entity 的参数错误 err (例如格式错误的 ID) 转换为携带 ErrorReason 的 Kratos BadRequest 错误，其 cause 为 err
+func invalidArgument(entity string, err error) error {
+	return kerrors.BadRequest(invalidArgumentReasons[entity].String(), err.Error()).WithCause(err)
+}
+
+// DefaultPageSize 与 MaxPageSize 是 ListXPage 未指定与允许的最大每页数量
+var (
+	DefaultPageSize = 20
+	MaxPageSize     = 1000
 )
 
+// pageCursor 是分页游标的内容: 上一页最后一行的排序字段值与 ID
+type pageCursor[V, ID any] struct {
+	Value V  `json:"v,omitempty"`
+	ID    ID `json:"id"`
+}
+
+func encodePageCursor(v, id any) (string, error) {
+	data, err := json.Marshal(pageCursor[any, any]{Value: v, ID: id})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodePageCursor[V, ID any](token string) (*pageCursor[V, ID], error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token: %w", err)
+	}
+	var c pageCursor[V, ID]
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("invalid page token: %w", err)
+	}
+	return &c, nil
+}
+
+// clampPageSize 将每页数量限制在 [1, MaxPageSize]，0 或负数使用 DefaultPageSize
+func clampPageSize(size int) int {
+	switch {
+	case size <= 0:
+		return DefaultPageSize
+	case size > MaxPageSize:
+		return MaxPageSize
+	}
+	return size
+}
 func EntGroupToBiz(e *ent.Group) (*biz.Group, error) {
 	if e == nil {
 		return nil, errors.New("EntGroupToBiz: nil entity")
 	}
-	var users []*biz.User
-	for _, item := range e.Edges.Users {
+	var moderators []*biz.User
+	for _, item := range e.Edges.Moderators {
 		v, err := EntUserToBiz(item)
 		if err != nil {
 			return nil, err
 		}
-		users = append(users, v)
+		moderators = append(moderators, v)
+	}
+	var memberships []*biz.GroupMembership
+	for _, item := range e.Edges.Memberships {
+		v, err := EntGroupMembershipToBiz(item)
+		if err != nil {
+			return nil, err
+		}
+		memberships = append(memberships, v)
 	}
 	return &biz.Group{
 		GroupBase: biz.GroupBase{
-			UUID:      e.ID.String(),
-			CreatedAt: e.CreatedAt,
-			UpdatedAt: e.UpdatedAt,
-			Name:      e.Name,
-			Users:     users,
+			UUID:        e.ID.String(),
+			CreatedAt:   e.CreatedAt,
+			UpdatedAt:   e.UpdatedAt,
+			Name:        e.Name,
+			TenantID:    e.TenantID,
+			Moderators:  moderators,
+			Memberships: memberships,
 		},
 	}, nil
 }
@@ -38,36 +115,526 @@ func BizGroupToEnt(b *biz.Group) (*ent.Group, error) {
 	if b == nil {
 		return nil, errors.New("BizGroupToEnt: nil entity")
 	}
-	var users []*ent.User
-	for _, item := range b.Users {
+	var moderators []*ent.User
+	for _, item := range b.Moderators {
 		v, err := BizUserToEnt(item)
 		if err != nil {
 			return nil, err
 		}
-		users = append(users, v)
+		moderators = append(moderators, v)
+	}
+	var memberships []*ent.Membership
+	for _, item := range b.Memberships {
+		v, err := BizGroupMembershipToEnt(item)
+		if err != nil {
+			return nil, err
+		}
+		memberships = append(memberships, v)
 	}
 	iDEntVal, err := uuid.Parse(b.UUID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid UUID for id: %w", err)
+		return nil, invalidArgument("Group", fmt.Errorf("invalid UUID for id: %w", err))
 	}
 	return &ent.Group{
 		ID:        iDEntVal,
 		CreatedAt: b.CreatedAt,
 		UpdatedAt: b.UpdatedAt,
 		Name:      b.Name,
+		TenantID:  b.TenantID,
 		Edges: ent.GroupEdges{
-			Users: users,
+			Moderators:  moderators,
+			Memberships: memberships,
 		},
 	}, nil
 }
 
+// BuildGroupCreate 根据 b 构造 Group 的创建 Builder，Optional 与带默认值字段的零值视为未设置 (bool 字段总是写入)
+// 经由 Edge Schema 的关联不会写入，需通过其实体维护
+func BuildGroupCreate(c *ent.GroupClient, b *biz.Group) (*ent.GroupCreate, error) {
+	if b == nil {
+		return nil, errors.New("BuildGroupCreate: nil entity")
+	}
+	m := c.Create()
+	if b.UUID != "" {
+		id, err := uuid.Parse(b.UUID)
+		if err != nil {
+			return nil, invalidArgument("Group", fmt.Errorf("invalid UUID for id: %w", err))
+		}
+		m.SetID(id)
+	}
+	if !b.CreatedAt.IsZero() {
+		m.SetCreatedAt(b.CreatedAt)
+	}
+	if !b.UpdatedAt.IsZero() {
+		m.SetUpdatedAt(b.UpdatedAt)
+	}
+	m.SetName(b.Name)
+	m.SetTenantID(b.TenantID)
+	if len(b.Moderators) > 0 {
+		ids := make([]uuid.UUID, 0, len(b.Moderators))
+		for _, item := range b.Moderators {
+			if item == nil {
+				continue
+			}
+			id, err := uuid.Parse(item.UUID)
+			if err != nil {
+				return nil, invalidArgument("Group", fmt.Errorf("invalid UUID for id: %w", err))
+			}
+			ids = append(ids, id)
+		}
+		m.AddModeratorIDs(ids...)
+	}
+	return m, nil
+}
+
+// BuildGroupUpdate 将 b 的可变字段全部写入 u，Optional 字段的零值会被清空，关联 ID 会被替换 (不会解除必需关联)
+// 非 Optional 字段中无法解析的零值 (如空 UUID) 保持不变，经由 Edge Schema 的关联不会写入，需通过其实体维护
+func BuildGroupUpdate(u *ent.GroupUpdateOne, b *biz.Group) (*ent.GroupUpdateOne, error) {
+	if b == nil {
+		return nil, errors.New("BuildGroupUpdate: nil entity")
+	}
+	u.SetName(b.Name)
+	moderatorIDs := make([]uuid.UUID, 0, len(b.Moderators))
+	for _, item := range b.Moderators {
+		if item == nil {
+			continue
+		}
+		id, err := uuid.Parse(item.UUID)
+		if err != nil {
+			return nil, invalidArgument("Group", fmt.Errorf("invalid UUID for id: %w", err))
+		}
+		moderatorIDs = append(moderatorIDs, id)
+	}
+	u.ClearModerators().AddModeratorIDs(moderatorIDs...)
+	return u, nil
+}
+
+func EntGroupMembershipToBiz(e *ent.Membership) (*biz.GroupMembership, error) {
+	if e == nil {
+		return nil, errors.New("EntGroupMembershipToBiz: nil entity")
+	}
+	b := &biz.GroupMembership{
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+		Role:      EntMembershipRoleToBiz(e.Role),
+		JoinedAt:  e.JoinedAt,
+	}
+	if e.Edges.User != nil {
+		v, err := EntUserToBiz(e.Edges.User)
+		if err != nil {
+			return nil, err
+		}
+		b.User = v
+	}
+	return b, nil
+}
+
+func BizGroupMembershipToEnt(b *biz.GroupMembership) (*ent.Membership, error) {
+	if b == nil {
+		return nil, errors.New("BizGroupMembershipToEnt: nil entity")
+	}
+	e := &ent.Membership{
+		CreatedAt: b.CreatedAt,
+		UpdatedAt: b.UpdatedAt,
+		Role:      BizMembershipRoleToEnt(b.Role),
+		JoinedAt:  b.JoinedAt,
+	}
+	if b.User != nil {
+		v, err := BizUserToEnt(b.User)
+		if err != nil {
+			return nil, err
+		}
+		e.UserID = v.ID
+		e.Edges.User = v
+	}
+	return e, nil
+}
+
+// ApplyGroupUpdate 按 FieldMask 路径 (proto 字段名) 将 b 中的字段写入 u，仅调用被选中字段的 Setter
+func ApplyGroupUpdate(u *ent.GroupUpdateOne, b *biz.Group, mask []string) error {
+	if b == nil {
+		return errors.New("ApplyGroupUpdate: nil entity")
+	}
+	for _, path := range mask {
+		switch path {
+		case "name", "moderator_ids":
+		case "uuid", "created_at", "updated_at", "tenant_id":
+			return invalidArgument("Group", fmt.Errorf("ApplyGroupUpdate: field %q is immutable", path))
+		default:
+			return invalidArgument("Group", fmt.Errorf("ApplyGroupUpdate: unknown field %q", path))
+		}
+	}
+	for _, path := range mask {
+		switch path {
+		case "name":
+			u.SetName(b.Name)
+		case "moderator_ids":
+			moderatorIDs := make([]uuid.UUID, 0, len(b.Moderators))
+			for _, item := range b.Moderators {
+				if item == nil {
+					continue
+				}
+				id, err := uuid.Parse(item.UUID)
+				if err != nil {
+					return invalidArgument("Group", fmt.Errorf("invalid UUID for id: %w", err))
+				}
+				moderatorIDs = append(moderatorIDs, id)
+			}
+			u.ClearModerators().AddModeratorIDs(moderatorIDs...)
+		}
+	}
+	return nil
+}
+
+// GroupFilterPredicates 将 f 转换为 ent 查询条件，nil 或空的条件不参与过滤
+func GroupFilterPredicates(f *biz.GroupFilter) ([]predicate.Group, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var ps []predicate.Group
+	if v := f.UUID; v != nil {
+		if v.EQ != nil {
+			val, err := uuid.Parse(*v.EQ)
+			if err != nil {
+				return nil, invalidArgument("Group", fmt.Errorf("invalid UUID for id: %w", err))
+			}
+			ps = append(ps, group.IDEQ(val))
+		}
+		if len(v.In) > 0 {
+			vals := make([]uuid.UUID, 0, len(v.In))
+			for _, item := range v.In {
+				val, err := uuid.Parse(item)
+				if err != nil {
+					return nil, invalidArgument("Group", fmt.Errorf("invalid UUID for id: %w", err))
+				}
+				vals = append(vals, val)
+			}
+			ps = append(ps, group.IDIn(vals...))
+		}
+	}
+	if v := f.CreatedAt; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, group.CreatedAtEQ(*v.EQ))
+		}
+		if len(v.In) > 0 {
+			ps = append(ps, group.CreatedAtIn(v.In...))
+		}
+		if v.GT != nil {
+			ps = append(ps, group.CreatedAtGT(*v.GT))
+		}
+		if v.GTE != nil {
+			ps = append(ps, group.CreatedAtGTE(*v.GTE))
+		}
+		if v.LT != nil {
+			ps = append(ps, group.CreatedAtLT(*v.LT))
+		}
+		if v.LTE != nil {
+			ps = append(ps, group.CreatedAtLTE(*v.LTE))
+		}
+	}
+	if v := f.UpdatedAt; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, group.UpdatedAtEQ(*v.EQ))
+		}
+		if len(v.In) > 0 {
+			ps = append(ps, group.UpdatedAtIn(v.In...))
+		}
+		if v.GT != nil {
+			ps = append(ps, group.UpdatedAtGT(*v.GT))
+		}
+		if v.GTE != nil {
+			ps = append(ps, group.UpdatedAtGTE(*v.GTE))
+		}
+		if v.LT != nil {
+			ps = append(ps, group.UpdatedAtLT(*v.LT))
+		}
+		if v.LTE != nil {
+			ps = append(ps, group.UpdatedAtLTE(*v.LTE))
+		}
+	}
+	if v := f.Name; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, group.NameEQ(*v.EQ))
+		}
+		if len(v.In) > 0 {
+			ps = append(ps, group.NameIn(v.In...))
+		}
+		if v.Contains != nil {
+			ps = append(ps, group.NameContains(*v.Contains))
+		}
+	}
+	return ps, nil
+}
+
+// ListGroupPage 按 opts 对 q 进行过滤、排序与游标分页，返回当前页与下一页的游标 (没有更多数据时为空)
+func ListGroupPage(ctx context.Context, q *ent.GroupQuery, opts *biz.GroupListOptions) ([]*ent.Group, string, error) {
+	if opts == nil {
+		opts = &biz.GroupListOptions{}
+	}
+	ps, err := GroupFilterPredicates(opts.Filter)
+	if err != nil {
+		return nil, "", err
+	}
+	q = q.Where(ps...)
+	order := ent.Asc
+	if opts.Desc {
+		order = ent.Desc
+	}
+	var cursor func(e *ent.Group) (string, error)
+	switch opts.OrderBy {
+	case biz.GroupOrderByID:
+		if opts.PageToken != "" {
+			c, err := decodePageCursor[any, uuid.UUID](opts.PageToken)
+			if err != nil {
+				return nil, "", invalidArgument("Group", err)
+			}
+			if opts.Desc {
+				q = q.Where(group.IDLT(c.ID))
+			} else {
+				q = q.Where(group.IDGT(c.ID))
+			}
+		}
+		q = q.Order(order(group.FieldID))
+		cursor = func(e *ent.Group) (string, error) { return encodePageCursor(nil, e.ID) }
+	case biz.GroupOrderByCreatedAt:
+		if opts.PageToken != "" {
+			c, err := decodePageCursor[time.Time, uuid.UUID](opts.PageToken)
+			if err != nil {
+				return nil, "", invalidArgument("Group", err)
+			}
+			if opts.Desc {
+				q = q.Where(group.Or(group.CreatedAtLT(c.Value), group.And(group.CreatedAtEQ(c.Value), group.IDLT(c.ID))))
+			} else {
+				q = q.Where(group.Or(group.CreatedAtGT(c.Value), group.And(group.CreatedAtEQ(c.Value), group.IDGT(c.ID))))
+			}
+		}
+		q = q.Order(order(group.FieldCreatedAt, group.FieldID))
+		cursor = func(e *ent.Group) (string, error) { return encodePageCursor(e.CreatedAt, e.ID) }
+	case biz.GroupOrderByUpdatedAt:
+		if opts.PageToken != "" {
+			c, err := decodePageCursor[time.Time, uuid.UUID](opts.PageToken)
+			if err != nil {
+				return nil, "", invalidArgument("Group", err)
+			}
+			if opts.Desc {
+				q = q.Where(group.Or(group.UpdatedAtLT(c.Value), group.And(group.UpdatedAtEQ(c.Value), group.IDLT(c.ID))))
+			} else {
+				q = q.Where(group.Or(group.UpdatedAtGT(c.Value), group.And(group.UpdatedAtEQ(c.Value), group.IDGT(c.ID))))
+			}
+		}
+		q = q.Order(order(group.FieldUpdatedAt, group.FieldID))
+		cursor = func(e *ent.Group) (string, error) { return encodePageCursor(e.UpdatedAt, e.ID) }
+	case biz.GroupOrderByName:
+		if opts.PageToken != "" {
+			c, err := decodePageCursor[string, uuid.UUID](opts.PageToken)
+			if err != nil {
+				return nil, "", invalidArgument("Group", err)
+			}
+			if opts.Desc {
+				q = q.Where(group.Or(group.NameLT(c.Value), group.And(group.NameEQ(c.Value), group.IDLT(c.ID))))
+			} else {
+				q = q.Where(group.Or(group.NameGT(c.Value), group.And(group.NameEQ(c.Value), group.IDGT(c.ID))))
+			}
+		}
+		q = q.Order(order(group.FieldName, group.FieldID))
+		cursor = func(e *ent.Group) (string, error) { return encodePageCursor(e.Name, e.ID) }
+	default:
+		return nil, "", invalidArgument("Group", fmt.Errorf("ListGroupPage: unknown order field %q", opts.OrderBy))
+	}
+	size := clampPageSize(opts.PageSize)
+	es, err := q.Limit(size + 1).All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(es) <= size {
+		return es, "", nil
+	}
+	es = es[:size]
+	next, err := cursor(es[size-1])
+	if err != nil {
+		return nil, "", err
+	}
+	return es, next, nil
+}
+func EntMembershipToBiz(e *ent.Membership) (*biz.Membership, error) {
+	if e == nil {
+		return nil, errors.New("EntMembershipToBiz: nil entity")
+	}
+	var user *biz.User
+	if e.Edges.User != nil {
+		v, err := EntUserToBiz(e.Edges.User)
+		if err != nil {
+			return nil, err
+		}
+		user = v
+	}
+	var group *biz.Group
+	if e.Edges.Group != nil {
+		v, err := EntGroupToBiz(e.Edges.Group)
+		if err != nil {
+			return nil, err
+		}
+		group = v
+	}
+	return &biz.Membership{
+		MembershipBase: biz.MembershipBase{
+			UUID:      e.ID.String(),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			Role:      EntMembershipRoleToBiz(e.Role),
+			JoinedAt:  e.JoinedAt,
+			UserID:    e.UserID.String(),
+			GroupID:   e.GroupID.String(),
+			User:      user,
+			Group:     group,
+		},
+	}, nil
+}
+
+func BizMembershipToEnt(b *biz.Membership) (*ent.Membership, error) {
+	if b == nil {
+		return nil, errors.New("BizMembershipToEnt: nil entity")
+	}
+	var user *ent.User
+	if b.User != nil {
+		v, err := BizUserToEnt(b.User)
+		if err != nil {
+			return nil, err
+		}
+		user = v
+	}
+	var group *ent.Group
+	if b.Group != nil {
+		v, err := BizGroupToEnt(b.Group)
+		if err != nil {
+			return nil, err
+		}
+		group = v
+	}
+	iDEntVal, err := uuid.Parse(b.UUID)
+	if err != nil {
+		return nil, invalidArgument("Membership", fmt.Errorf("invalid UUID for id: %w", err))
+	}
+	userIDEntVal, err := uuid.Parse(b.UserID)
+	if err != nil {
+		return nil, invalidArgument("Membership", fmt.Errorf("invalid UUID for user_id: %w", err))
+	}
+	groupIDEntVal, err := uuid.Parse(b.GroupID)
+	if err != nil {
+		return nil, invalidArgument("Membership", fmt.Errorf("invalid UUID for group_id: %w", err))
+	}
+	return &ent.Membership{
+		ID:        iDEntVal,
+		CreatedAt: b.CreatedAt,
+		UpdatedAt: b.UpdatedAt,
+		Role:      BizMembershipRoleToEnt(b.Role),
+		JoinedAt:  b.JoinedAt,
+		UserID:    userIDEntVal,
+		GroupID:   groupIDEntVal,
+		Edges: ent.MembershipEdges{
+			User:  user,
+			Group: group,
+		},
+	}, nil
+}
+
+// BuildMembershipCreate 根据 b 构造 Membership 的创建 Builder，Optional 与带默认值字段的零值视为未设置 (bool 字段总是写入)
+func BuildMembershipCreate(c *ent.MembershipClient, b *biz.Membership) (*ent.MembershipCreate, error) {
+	if b == nil {
+		return nil, errors.New("BuildMembershipCreate: nil entity")
+	}
+	m := c.Create()
+	if b.UUID != "" {
+		id, err := uuid.Parse(b.UUID)
+		if err != nil {
+			return nil, invalidArgument("Membership", fmt.Errorf("invalid UUID for id: %w", err))
+		}
+		m.SetID(id)
+	}
+	if !b.CreatedAt.IsZero() {
+		m.SetCreatedAt(b.CreatedAt)
+	}
+	if !b.UpdatedAt.IsZero() {
+		m.SetUpdatedAt(b.UpdatedAt)
+	}
+	m.SetRole(BizMembershipRoleToEnt(b.Role))
+	if !b.JoinedAt.IsZero() {
+		m.SetJoinedAt(b.JoinedAt)
+	}
+	userIDVal, err := uuid.Parse(b.UserID)
+	if err != nil {
+		return nil, invalidArgument("Membership", fmt.Errorf("invalid UUID for user_id: %w", err))
+	}
+	m.SetUserID(userIDVal)
+	groupIDVal, err := uuid.Parse(b.GroupID)
+	if err != nil {
+		return nil, invalidArgument("Membership", fmt.Errorf("invalid UUID for group_id: %w", err))
+	}
+	m.SetGroupID(groupIDVal)
+	return m, nil
+}
+
+// BuildMembershipUpdate 将 b 的可变字段全部写入 u，Optional 字段的零值会被清空，关联 ID 会被替换 (不会解除必需关联)
+// 非 Optional 字段中无法解析的零值 (如空 UUID) 保持不变
+func BuildMembershipUpdate(u *ent.MembershipUpdateOne, b *biz.Membership) (*ent.MembershipUpdateOne, error) {
+	if b == nil {
+		return nil, errors.New("BuildMembershipUpdate: nil entity")
+	}
+	u.SetRole(BizMembershipRoleToEnt(b.Role))
+	u.SetJoinedAt(b.JoinedAt)
+	if b.UserID != "" {
+		userIDVal, err := uuid.Parse(b.UserID)
+		if err != nil {
+			return nil, invalidArgument("Membership", fmt.Errorf("invalid UUID for user_id: %w", err))
+		}
+		u.SetUserID(userIDVal)
+	}
+	if b.GroupID != "" {
+		groupIDVal, err := uuid.Parse(b.GroupID)
+		if err != nil {
+			return nil, invalidArgument("Membership", fmt.Errorf("invalid UUID for group_id: %w", err))
+		}
+		u.SetGroupID(groupIDVal)
+	}
+	return u, nil
+}
+
+func EntMembershipRoleToBiz(v membership.Role) biz.MembershipRole {
+	switch v {
+	case membership.RoleUNSPECIFIED:
+		return biz.MembershipRoleUnspecified
+	case membership.RoleMEMBER:
+		return biz.MembershipRoleMember
+	case membership.RoleOWNER:
+		return biz.MembershipRoleOwner
+	default:
+		return 0
+	}
+}
+
+func BizMembershipRoleToEnt(v biz.MembershipRole) membership.Role {
+	switch v {
+	case biz.MembershipRoleUnspecified:
+		return membership.RoleUNSPECIFIED
+	case biz.MembershipRoleMember:
+		return membership.RoleMEMBER
+	case biz.MembershipRoleOwner:
+		return membership.RoleOWNER
+	default:
+		return ""
+	}
+}
 func EntPostToBiz(e *ent.Post) (*biz.Post, error) {
 	if e == nil {
 		return nil, errors.New("EntPostToBiz: nil entity")
 	}
-	author, err := EntUserToBiz(e.Edges.Author)
-	if err != nil {
-		return nil, err
+	var author *biz.User
+	if e.Edges.Author != nil {
+		v, err := EntUserToBiz(e.Edges.Author)
+		if err != nil {
+			return nil, err
+		}
+		author = v
 	}
 	return &biz.Post{
 		PostBase: biz.PostBase{
@@ -85,13 +652,17 @@ func BizPostToEnt(b *biz.Post) (*ent.Post, error) {
 	if b == nil {
 		return nil, errors.New("BizPostToEnt: nil entity")
 	}
-	author, err := BizUserToEnt(b.Author)
-	if err != nil {
-		return nil, err
+	var author *ent.User
+	if b.Author != nil {
+		v, err := BizUserToEnt(b.Author)
+		if err != nil {
+			return nil, err
+		}
+		author = v
 	}
 	iDEntVal, err := uuid.Parse(b.UUID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid UUID for id: %w", err)
+		return nil, invalidArgument("Post", fmt.Errorf("invalid UUID for id: %w", err))
 	}
 	return &ent.Post{
 		ID:        iDEntVal,
@@ -105,6 +676,250 @@ func BizPostToEnt(b *biz.Post) (*ent.Post, error) {
 	}, nil
 }
 
+// BuildPostCreate 根据 b 构造 Post 的创建 Builder，Optional 与带默认值字段的零值视为未设置 (bool 字段总是写入)
+func BuildPostCreate(c *ent.PostClient, b *biz.Post) (*ent.PostCreate, error) {
+	if b == nil {
+		return nil, errors.New("BuildPostCreate: nil entity")
+	}
+	m := c.Create()
+	if b.UUID != "" {
+		id, err := uuid.Parse(b.UUID)
+		if err != nil {
+			return nil, invalidArgument("Post", fmt.Errorf("invalid UUID for id: %w", err))
+		}
+		m.SetID(id)
+	}
+	if !b.CreatedAt.IsZero() {
+		m.SetCreatedAt(b.CreatedAt)
+	}
+	if !b.UpdatedAt.IsZero() {
+		m.SetUpdatedAt(b.UpdatedAt)
+	}
+	m.SetTitle(b.Title)
+	m.SetContent(b.Content)
+	if b.Author != nil {
+		id, err := uuid.Parse(b.Author.UUID)
+		if err != nil {
+			return nil, invalidArgument("Post", fmt.Errorf("invalid UUID for id: %w", err))
+		}
+		m.SetAuthorID(id)
+	}
+	return m, nil
+}
+
+// BuildPostUpdate 将 b 的可变字段全部写入 u，Optional 字段的零值会被清空，关联 ID 会被替换 (不会解除必需关联)
+// 非 Optional 字段中无法解析的零值 (如空 UUID) 保持不变
+func BuildPostUpdate(u *ent.PostUpdateOne, b *biz.Post) (*ent.PostUpdateOne, error) {
+	if b == nil {
+		return nil, errors.New("BuildPostUpdate: nil entity")
+	}
+	u.SetTitle(b.Title)
+	u.SetContent(b.Content)
+	if b.Author != nil {
+		id, err := uuid.Parse(b.Author.UUID)
+		if err != nil {
+			return nil, invalidArgument("Post", fmt.Errorf("invalid UUID for id: %w", err))
+		}
+		u.SetAuthorID(id)
+	}
+	return u, nil
+}
+
+// PostFilterPredicates 将 f 转换为 ent 查询条件，nil 或空的条件不参与过滤
+func PostFilterPredicates(f *biz.PostFilter) ([]predicate.Post, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var ps []predicate.Post
+	if v := f.UUID; v != nil {
+		if v.EQ != nil {
+			val, err := uuid.Parse(*v.EQ)
+			if err != nil {
+				return nil, invalidArgument("Post", fmt.Errorf("invalid UUID for id: %w", err))
+			}
+			ps = append(ps, post.IDEQ(val))
+		}
+		if len(v.In) > 0 {
+			vals := make([]uuid.UUID, 0, len(v.In))
+			for _, item := range v.In {
+				val, err := uuid.Parse(item)
+				if err != nil {
+					return nil, invalidArgument("Post", fmt.Errorf("invalid UUID for id: %w", err))
+				}
+				vals = append(vals, val)
+			}
+			ps = append(ps, post.IDIn(vals...))
+		}
+	}
+	if v := f.CreatedAt; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, post.CreatedAtEQ(*v.EQ))
+		}
+		if len(v.In) > 0 {
+			ps = append(ps, post.CreatedAtIn(v.In...))
+		}
+		if v.GT != nil {
+			ps = append(ps, post.CreatedAtGT(*v.GT))
+		}
+		if v.GTE != nil {
+			ps = append(ps, post.CreatedAtGTE(*v.GTE))
+		}
+		if v.LT != nil {
+			ps = append(ps, post.CreatedAtLT(*v.LT))
+		}
+		if v.LTE != nil {
+			ps = append(ps, post.CreatedAtLTE(*v.LTE))
+		}
+	}
+	if v := f.UpdatedAt; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, post.UpdatedAtEQ(*v.EQ))
+		}
+		if len(v.In) > 0 {
+			ps = append(ps, post.UpdatedAtIn(v.In...))
+		}
+		if v.GT != nil {
+			ps = append(ps, post.UpdatedAtGT(*v.GT))
+		}
+		if v.GTE != nil {
+			ps = append(ps, post.UpdatedAtGTE(*v.GTE))
+		}
+		if v.LT != nil {
+			ps = append(ps, post.UpdatedAtLT(*v.LT))
+		}
+		if v.LTE != nil {
+			ps = append(ps, post.UpdatedAtLTE(*v.LTE))
+		}
+	}
+	if v := f.Title; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, post.TitleEQ(*v.EQ))
+		}
+		if len(v.In) > 0 {
+			ps = append(ps, post.TitleIn(v.In...))
+		}
+		if v.Contains != nil {
+			ps = append(ps, post.TitleContains(*v.Contains))
+		}
+	}
+	if v := f.Content; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, post.ContentEQ(*v.EQ))
+		}
+		if len(v.In) > 0 {
+			ps = append(ps, post.ContentIn(v.In...))
+		}
+		if v.Contains != nil {
+			ps = append(ps, post.ContentContains(*v.Contains))
+		}
+	}
+	return ps, nil
+}
+
+// ListPostPage 按 opts 对 q 进行过滤、排序与游标分页，返回当前页与下一页的游标 (没有更多数据时为空)
+func ListPostPage(ctx context.Context, q *ent.PostQuery, opts *biz.PostListOptions) ([]*ent.Post, string, error) {
+	if opts == nil {
+		opts = &biz.PostListOptions{}
+	}
+	ps, err := PostFilterPredicates(opts.Filter)
+	if err != nil {
+		return nil, "", err
+	}
+	q = q.Where(ps...)
+	order := ent.Asc
+	if opts.Desc {
+		order = ent.Desc
+	}
+	var cursor func(e *ent.Post) (string, error)
+	switch opts.OrderBy {
+	case biz.PostOrderByID:
+		if opts.PageToken != "" {
+			c, err := decodePageCursor[any, uuid.UUID](opts.PageToken)
+			if err != nil {
+				return nil, "", invalidArgument("Post", err)
+			}
+			if opts.Desc {
+				q = q.Where(post.IDLT(c.ID))
+			} else {
+				q = q.Where(post.IDGT(c.ID))
+			}
+		}
+		q = q.Order(order(post.FieldID))
+		cursor = func(e *ent.Post) (string, error) { return encodePageCursor(nil, e.ID) }
+	case biz.PostOrderByCreatedAt:
+		if opts.PageToken != "" {
+			c, err := decodePageCursor[time.Time, uuid.UUID](opts.PageToken)
+			if err != nil {
+				return nil, "", invalidArgument("Post", err)
+			}
+			if opts.Desc {
+				q = q.Where(post.Or(post.CreatedAtLT(c.Value), post.And(post.CreatedAtEQ(c.Value), post.IDLT(c.ID))))
+			} else {
+				q = q.Where(post.Or(post.CreatedAtGT(c.Value), post.And(post.CreatedAtEQ(c.Value), post.IDGT(c.ID))))
+			}
+		}
+		q = q.Order(order(post.FieldCreatedAt, post.FieldID))
+		cursor = func(e *ent.Post) (string, error) { return encodePageCursor(e.CreatedAt, e.ID) }
+	case biz.PostOrderByUpdatedAt:
+		if opts.PageToken != "" {
+			c, err := decodePageCursor[time.Time, uuid.UUID](opts.PageToken)
+			if err != nil {
+				return nil, "", invalidArgument("Post", err)
+			}
+			if opts.Desc {
+				q = q.Where(post.Or(post.UpdatedAtLT(c.Value), post.And(post.UpdatedAtEQ(c.Value), post.IDLT(c.ID))))
+			} else {
+				q = q.Where(post.Or(post.UpdatedAtGT(c.Value), post.And(post.UpdatedAtEQ(c.Value), post.IDGT(c.ID))))
+			}
+		}
+		q = q.Order(order(post.FieldUpdatedAt, post.FieldID))
+		cursor = func(e *ent.Post) (string, error) { return encodePageCursor(e.UpdatedAt, e.ID) }
+	case biz.PostOrderByTitle:
+		if opts.PageToken != "" {
+			c, err := decodePageCursor[string, uuid.UUID](opts.PageToken)
+			if err != nil {
+				return nil, "", invalidArgument("Post", err)
+			}
+			if opts.Desc {
+				q = q.Where(post.Or(post.TitleLT(c.Value), post.And(post.TitleEQ(c.Value), post.IDLT(c.ID))))
+			} else {
+				q = q.Where(post.Or(post.TitleGT(c.Value), post.And(post.TitleEQ(c.Value), post.IDGT(c.ID))))
+			}
+		}
+		q = q.Order(order(post.FieldTitle, post.FieldID))
+		cursor = func(e *ent.Post) (string, error) { return encodePageCursor(e.Title, e.ID) }
+	case biz.PostOrderByContent:
+		if opts.PageToken != "" {
+			c, err := decodePageCursor[string, uuid.UUID](opts.PageToken)
+			if err != nil {
+				return nil, "", invalidArgument("Post", err)
+			}
+			if opts.Desc {
+				q = q.Where(post.Or(post.ContentLT(c.Value), post.And(post.ContentEQ(c.Value), post.IDLT(c.ID))))
+			} else {
+				q = q.Where(post.Or(post.ContentGT(c.Value), post.And(post.ContentEQ(c.Value), post.IDGT(c.ID))))
+			}
+		}
+		q = q.Order(order(post.FieldContent, post.FieldID))
+		cursor = func(e *ent.Post) (string, error) { return encodePageCursor(e.Content, e.ID) }
+	default:
+		return nil, "", invalidArgument("Post", fmt.Errorf("ListPostPage: unknown order field %q", opts.OrderBy))
+	}
+	size := clampPageSize(opts.PageSize)
+	es, err := q.Limit(size + 1).All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(es) <= size {
+		return es, "", nil
+	}
+	es = es[:size]
+	next, err := cursor(es[size-1])
+	if err != nil {
+		return nil, "", err
+	}
+	return es, next, nil
+}
 func EntUserToBiz(e *ent.User) (*biz.User, error) {
 	if e == nil {
 		return nil, errors.New("EntUserToBiz: nil entity")
@@ -131,16 +946,19 @@ func EntUserToBiz(e *ent.User) (*biz.User, error) {
 	}
 	return &biz.User{
 		UserBase: biz.UserBase{
-			UUID:       e.ID.String(),
-			CreatedAt:  e.CreatedAt,
-			UpdatedAt:  e.UpdatedAt,
-			Name:       e.Name,
-			Age:        e.Age,
-			Nickname:   e.Nickname,
-			UserScore:  uint8(e.Score),
-			IsVerified: e.IsVerified,
-			Tags:       e.Tags,
-			TestUUID:   e.TestUUID.String(),
+			UUID:         e.ID.String(),
+			CreatedAt:    e.CreatedAt,
+			UpdatedAt:    e.UpdatedAt,
+			DeletedAt:    e.DeletedAt,
+			Name:         e.Name,
+			Age:          e.Age,
+			Nickname:     e.Nickname,
+			UserScore:    uint8(e.Score),
+			IsVerified:   e.IsVerified,
+			Tags:         e.Tags,
+			Password:     e.Password,
+			InternalNote: e.InternalNote,
+			TestUUID:     e.TestUUID.String(),
 			TestNillableUUID: func() string {
 				if e.TestNillableUUID != nil {
 					return e.TestNillableUUID.String()
@@ -149,6 +967,7 @@ func EntUserToBiz(e *ent.User) (*biz.User, error) {
 			}(),
 			Status:  EntUserStatusToBiz(e.Status),
 			Role:    e.Role,
+			Version: e.Version,
 			PostIDs: postIDs,
 			Groups:  groups,
 			Friends: friends,
@@ -188,17 +1007,17 @@ func BizUserToEnt(b *biz.User) (*ent.User, error) {
 	}
 	iDEntVal, err := uuid.Parse(b.UUID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid UUID for id: %w", err)
+		return nil, invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
 	}
 	testUUIDEntVal, err := uuid.Parse(b.TestUUID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid UUID for test_uuid: %w", err)
+		return nil, invalidArgument("User", fmt.Errorf("invalid UUID for test_uuid: %w", err))
 	}
 	var testNillableUUIDEntVal *uuid.UUID
 	if b.TestNillableUUID != "" {
 		parsed, err := uuid.Parse(b.TestNillableUUID)
 		if err != nil {
-			return nil, fmt.Errorf("invalid UUID for test_nillable_uuid: %w", err)
+			return nil, invalidArgument("User", fmt.Errorf("invalid UUID for test_nillable_uuid: %w", err))
 		}
 		testNillableUUIDEntVal = &parsed
 	}
@@ -206,16 +1025,20 @@ func BizUserToEnt(b *biz.User) (*ent.User, error) {
 		ID:               iDEntVal,
 		CreatedAt:        b.CreatedAt,
 		UpdatedAt:        b.UpdatedAt,
+		DeletedAt:        b.DeletedAt,
 		Name:             b.Name,
 		Age:              b.Age,
 		Nickname:         b.Nickname,
 		Score:            int(b.UserScore),
 		IsVerified:       b.IsVerified,
 		Tags:             b.Tags,
+		Password:         b.Password,
+		InternalNote:     b.InternalNote,
 		TestUUID:         testUUIDEntVal,
 		TestNillableUUID: testNillableUUIDEntVal,
 		Status:           BizUserStatusToEnt(b.Status),
 		Role:             b.Role,
+		Version:          b.Version,
 		Edges: ent.UserEdges{
 			Posts:   posts,
 			Groups:  groups,
@@ -224,6 +1047,161 @@ func BizUserToEnt(b *biz.User) (*ent.User, error) {
 	}, nil
 }
 
+// BuildUserCreate 根据 b 构造 User 的创建 Builder，Optional 与带默认值字段的零值视为未设置 (bool 字段总是写入)
+// 经由 Edge Schema 的关联不会写入，需通过其实体维护
+func BuildUserCreate(c *ent.UserClient, b *biz.User) (*ent.UserCreate, error) {
+	if b == nil {
+		return nil, errors.New("BuildUserCreate: nil entity")
+	}
+	m := c.Create()
+	if b.UUID != "" {
+		id, err := uuid.Parse(b.UUID)
+		if err != nil {
+			return nil, invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
+		}
+		m.SetID(id)
+	}
+	if !b.CreatedAt.IsZero() {
+		m.SetCreatedAt(b.CreatedAt)
+	}
+	if !b.UpdatedAt.IsZero() {
+		m.SetUpdatedAt(b.UpdatedAt)
+	}
+	if !b.DeletedAt.IsZero() {
+		m.SetDeletedAt(b.DeletedAt)
+	}
+	m.SetName(b.Name)
+	m.SetAge(b.Age)
+	if b.Nickname != "" {
+		m.SetNickname(b.Nickname)
+	}
+	if b.UserScore != 0 {
+		m.SetScore(int(b.UserScore))
+	}
+	m.SetIsVerified(b.IsVerified)
+	if len(b.Tags) > 0 {
+		m.SetTags(b.Tags)
+	}
+	if b.Password != "" {
+		m.SetPassword(b.Password)
+	}
+	if b.InternalNote != "" {
+		m.SetInternalNote(b.InternalNote)
+	}
+	if b.TestUUID != "" {
+		testUUIDVal, err := uuid.Parse(b.TestUUID)
+		if err != nil {
+			return nil, invalidArgument("User", fmt.Errorf("invalid UUID for test_uuid: %w", err))
+		}
+		m.SetTestUUID(testUUIDVal)
+	}
+	if b.TestNillableUUID != "" {
+		testNillableUUIDVal, err := uuid.Parse(b.TestNillableUUID)
+		if err != nil {
+			return nil, invalidArgument("User", fmt.Errorf("invalid UUID for test_nillable_uuid: %w", err))
+		}
+		m.SetTestNillableUUID(testNillableUUIDVal)
+	}
+	m.SetStatus(BizUserStatusToEnt(b.Status))
+	if b.Role != "" {
+		m.SetRole(b.Role)
+	}
+	if b.Version != 0 {
+		m.SetVersion(b.Version)
+	}
+	if len(b.PostIDs) > 0 {
+		ids := make([]uuid.UUID, 0, len(b.PostIDs))
+		for _, item := range b.PostIDs {
+			id, err := uuid.Parse(item)
+			if err != nil {
+				return nil, invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
+			}
+			ids = append(ids, id)
+		}
+		m.AddPostIDs(ids...)
+	}
+	if len(b.Friends) > 0 {
+		ids := make([]uuid.UUID, 0, len(b.Friends))
+		for _, item := range b.Friends {
+			if item == nil {
+				continue
+			}
+			id, err := uuid.Parse(item.UUID)
+			if err != nil {
+				return nil, invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
+			}
+			ids = append(ids, id)
+		}
+		m.AddFriendIDs(ids...)
+	}
+	return m, nil
+}
+
+// BuildUserUpdate 将 b 的可变字段全部写入 u，Optional 字段的零值会被清空，关联 ID 会被替换 (不会解除必需关联)
+// 非 Optional 字段中无法解析的零值 (如空 UUID) 保持不变，经由 Edge Schema 的关联不会写入，需通过其实体维护
+func BuildUserUpdate(u *ent.UserUpdateOne, b *biz.User) (*ent.UserUpdateOne, error) {
+	if b == nil {
+		return nil, errors.New("BuildUserUpdate: nil entity")
+	}
+	u.SetName(b.Name)
+	u.SetAge(b.Age)
+	if b.Nickname == "" {
+		u.ClearNickname()
+	} else {
+		u.SetNickname(b.Nickname)
+	}
+	if b.UserScore == 0 {
+		u.ClearScore()
+	} else {
+		u.SetScore(int(b.UserScore))
+	}
+	u.SetIsVerified(b.IsVerified)
+	if len(b.Tags) == 0 {
+		u.ClearTags()
+	} else {
+		u.SetTags(b.Tags)
+	}
+	if b.Password == "" {
+		u.ClearPassword()
+	} else {
+		u.SetPassword(b.Password)
+	}
+	if b.InternalNote == "" {
+		u.ClearInternalNote()
+	} else {
+		u.SetInternalNote(b.InternalNote)
+	}
+	if b.TestUUID != "" {
+		testUUIDVal, err := uuid.Parse(b.TestUUID)
+		if err != nil {
+			return nil, invalidArgument("User", fmt.Errorf("invalid UUID for test_uuid: %w", err))
+		}
+		u.SetTestUUID(testUUIDVal)
+	}
+	if b.TestNillableUUID != "" {
+		testNillableUUIDVal, err := uuid.Parse(b.TestNillableUUID)
+		if err != nil {
+			return nil, invalidArgument("User", fmt.Errorf("invalid UUID for test_nillable_uuid: %w", err))
+		}
+		u.SetTestNillableUUID(testNillableUUIDVal)
+	}
+	u.SetStatus(BizUserStatusToEnt(b.Status))
+	u.SetRole(b.Role)
+	friendIDs := make([]uuid.UUID, 0, len(b.Friends))
+	for _, item := range b.Friends {
+		if item == nil {
+			continue
+		}
+		id, err := uuid.Parse(item.UUID)
+		if err != nil {
+			return nil, invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
+		}
+		friendIDs = append(friendIDs, id)
+	}
+	u.ClearFriends().AddFriendIDs(friendIDs...)
+	return u, nil
+}
+
 func EntUserStatusToBiz(v user.Status) biz.UserStatus {
 	switch v {
 	case user.StatusUNSPECIFIED:
@@ -253,3 +1231,547 @@ func BizUserStatusToEnt(v biz.UserStatus) user.Status {
 		return ""
 	}
 }
+
+// FillUserPostCount 批量填充 User 的 PostCount，按外键分组聚合，仅需一次查询，计入的关联实体由 scope 限定
+func FillUserPostCount(ctx context.Context, client *ent.Client, items []*biz.User, scope EdgeScope) error {
+	var ids []uuid.UUID
+	index := make(map[uuid.UUID][]*biz.User, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		item.PostCount = 0
+		id, err := uuid.Parse(item.UUID)
+		if err != nil {
+			return invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
+		}
+		if _, ok := index[id]; !ok {
+			ids = append(ids, id)
+		}
+		index[id] = append(index[id], item)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	var rows []struct {
+		ID    uuid.UUID `json:"user_posts"`
+		Count int       `json:"count"`
+	}
+	q := client.Post.Query().
+		Where(predicate.Post(sql.FieldIn(user.PostsColumn, ids...)))
+	err := q.GroupBy(user.PostsColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		for _, item := range index[row.ID] {
+			item.PostCount = row.Count
+		}
+	}
+	return nil
+}
+
+// FillUserGroupCount 批量填充 User 的 GroupCount，按外键分组聚合，仅需一次查询，计入的关联实体由 scope 限定
+func FillUserGroupCount(ctx context.Context, client *ent.Client, items []*biz.User, scope EdgeScope) error {
+	var ids []uuid.UUID
+	index := make(map[uuid.UUID][]*biz.User, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		item.GroupCount = 0
+		id, err := uuid.Parse(item.UUID)
+		if err != nil {
+			return invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
+		}
+		if _, ok := index[id]; !ok {
+			ids = append(ids, id)
+		}
+		index[id] = append(index[id], item)
+	}
+	if len(ids) == 0 || !scope.HasTenant {
+		return nil
+	}
+	var rows []struct {
+		ID    uuid.UUID `json:"user_id"`
+		Count int       `json:"count"`
+	}
+	q := client.Membership.Query().
+		Where(predicate.Membership(sql.FieldIn(user.MembershipsColumn, ids...)))
+	q.Where(membership.HasGroupWith(group.TenantID(scope.Tenant)))
+	err := q.GroupBy(user.MembershipsColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		for _, item := range index[row.ID] {
+			item.GroupCount = row.Count
+		}
+	}
+	return nil
+}
+
+// FillUserFriendCount 批量填充 User 的 FriendCount，按关联表分组聚合，仅需一次查询，计入的关联实体由 scope 限定
+func FillUserFriendCount(ctx context.Context, client *ent.Client, items []*biz.User, scope EdgeScope) error {
+	var ids []uuid.UUID
+	index := make(map[uuid.UUID][]*biz.User, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		item.FriendCount = 0
+		id, err := uuid.Parse(item.UUID)
+		if err != nil {
+			return invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
+		}
+		if _, ok := index[id]; !ok {
+			ids = append(ids, id)
+		}
+		index[id] = append(index[id], item)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	var rows []struct {
+		ID    uuid.UUID `json:"uuid"`
+		Count int       `json:"count"`
+	}
+	err := client.User.Query().
+		Where(user.IDIn(ids...)).
+		GroupBy(user.FieldID).
+		Aggregate(func(s *sql.Selector) string {
+			t := sql.Table(user.FriendsTable)
+			s.Join(t).On(s.C(user.FieldID), t.C(user.FriendsPrimaryKey[0]))
+			if !scope.IncludeDeleted {
+				target := sql.Table(user.Table).As("target")
+				s.Join(target).On(t.C(user.FriendsPrimaryKey[1]), target.C(user.FieldID))
+				s.Where(sql.IsNull(target.C(user.FieldDeletedAt)))
+			}
+			return sql.As(sql.Count(t.C(user.FriendsPrimaryKey[1])), "count")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		for _, item := range index[row.ID] {
+			item.FriendCount = row.Count
+		}
+	}
+	return nil
+}
+
+// ApplyUserUpdate 按 FieldMask 路径 (proto 字段名) 将 b 中的字段写入 u，仅调用被选中字段的 Setter
+func ApplyUserUpdate(u *ent.UserUpdateOne, b *biz.User, mask []string) error {
+	if b == nil {
+		return errors.New("ApplyUserUpdate: nil entity")
+	}
+	for _, path := range mask {
+		switch path {
+		case "name", "age", "nickname", "user_score", "is_verified", "tags", "test_uuid", "test_nillable_uuid", "status", "role":
+		case "uuid", "created_at", "updated_at", "version":
+			return invalidArgument("User", fmt.Errorf("ApplyUserUpdate: field %q is immutable", path))
+		case "post_ids":
+			return invalidArgument("User", fmt.Errorf("ApplyUserUpdate: field %q is updated through Post.author", path))
+		case "group_ids":
+			return invalidArgument("User", fmt.Errorf("ApplyUserUpdate: field %q is updated through Membership", path))
+		default:
+			return invalidArgument("User", fmt.Errorf("ApplyUserUpdate: unknown field %q", path))
+		}
+	}
+	for _, path := range mask {
+		switch path {
+		case "name":
+			u.SetName(b.Name)
+		case "age":
+			u.SetAge(b.Age)
+		case "nickname":
+			if b.Nickname == "" {
+				u.ClearNickname()
+			} else {
+				u.SetNickname(b.Nickname)
+			}
+		case "user_score":
+			if b.UserScore == 0 {
+				u.ClearScore()
+			} else {
+				u.SetScore(int(b.UserScore))
+			}
+		case "is_verified":
+			u.SetIsVerified(b.IsVerified)
+		case "tags":
+			if len(b.Tags) == 0 {
+				u.ClearTags()
+			} else {
+				u.SetTags(b.Tags)
+			}
+		case "test_uuid":
+			if b.TestUUID != "" {
+				testUUIDVal, err := uuid.Parse(b.TestUUID)
+				if err != nil {
+					return invalidArgument("User", fmt.Errorf("invalid UUID for test_uuid: %w", err))
+				}
+				u.SetTestUUID(testUUIDVal)
+			}
+		case "test_nillable_uuid":
+			if b.TestNillableUUID != "" {
+				testNillableUUIDVal, err := uuid.Parse(b.TestNillableUUID)
+				if err != nil {
+					return invalidArgument("User", fmt.Errorf("invalid UUID for test_nillable_uuid: %w", err))
+				}
+				u.SetTestNillableUUID(testNillableUUIDVal)
+			}
+		case "status":
+			u.SetStatus(BizUserStatusToEnt(b.Status))
+		case "role":
+			u.SetRole(b.Role)
+		}
+	}
+	return nil
+}
+
+// UserFilterPredicates 将 f 转换为 ent 查询条件，nil 或空的条件不参与过滤
+func UserFilterPredicates(f *biz.UserFilter) ([]predicate.User, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var ps []predicate.User
+	if v := f.UUID; v != nil {
+		if v.EQ != nil {
+			val, err := uuid.Parse(*v.EQ)
+			if err != nil {
+				return nil, invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
+			}
+			ps = append(ps, user.IDEQ(val))
+		}
+		if len(v.In) > 0 {
+			vals := make([]uuid.UUID, 0, len(v.In))
+			for _, item := range v.In {
+				val, err := uuid.Parse(item)
+				if err != nil {
+					return nil, invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
+				}
+				vals = append(vals, val)
+			}
+			ps = append(ps, user.IDIn(vals...))
+		}
+	}
+	if v := f.CreatedAt; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, user.CreatedAtEQ(*v.EQ))
+		}
+		if len(v.In) > 0 {
+			ps = append(ps, user.CreatedAtIn(v.In...))
+		}
+		if v.GT != nil {
+			ps = append(ps, user.CreatedAtGT(*v.GT))
+		}
+		if v.GTE != nil {
+			ps = append(ps, user.CreatedAtGTE(*v.GTE))
+		}
+		if v.LT != nil {
+			ps = append(ps, user.CreatedAtLT(*v.LT))
+		}
+		if v.LTE != nil {
+			ps = append(ps, user.CreatedAtLTE(*v.LTE))
+		}
+	}
+	if v := f.UpdatedAt; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, user.UpdatedAtEQ(*v.EQ))
+		}
+		if len(v.In) > 0 {
+			ps = append(ps, user.UpdatedAtIn(v.In...))
+		}
+		if v.GT != nil {
+			ps = append(ps, user.UpdatedAtGT(*v.GT))
+		}
+		if v.GTE != nil {
+			ps = append(ps, user.UpdatedAtGTE(*v.GTE))
+		}
+		if v.LT != nil {
+			ps = append(ps, user.UpdatedAtLT(*v.LT))
+		}
+		if v.LTE != nil {
+			ps = append(ps, user.UpdatedAtLTE(*v.LTE))
+		}
+	}
+	if v := f.Name; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, user.NameEQ(*v.EQ))
+		}
+		if len(v.In) > 0 {
+			ps = append(ps, user.NameIn(v.In...))
+		}
+		if v.Contains != nil {
+			ps = append(ps, user.NameContains(*v.Contains))
+		}
+	}
+	if v := f.Age; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, user.AgeEQ(*v.EQ))
+		}
+		if len(v.In) > 0 {
+			ps = append(ps, user.AgeIn(v.In...))
+		}
+		if v.GT != nil {
+			ps = append(ps, user.AgeGT(*v.GT))
+		}
+		if v.GTE != nil {
+			ps = append(ps, user.AgeGTE(*v.GTE))
+		}
+		if v.LT != nil {
+			ps = append(ps, user.AgeLT(*v.LT))
+		}
+		if v.LTE != nil {
+			ps = append(ps, user.AgeLTE(*v.LTE))
+		}
+	}
+	if v := f.Nickname; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, user.NicknameEQ(*v.EQ))
+		}
+		if len(v.In) > 0 {
+			ps = append(ps, user.NicknameIn(v.In...))
+		}
+		if v.Contains != nil {
+			ps = append(ps, user.NicknameContains(*v.Contains))
+		}
+	}
+	if v := f.UserScore; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, user.ScoreEQ(int(*v.EQ)))
+		}
+		if len(v.In) > 0 {
+			vals := make([]int, 0, len(v.In))
+			for _, item := range v.In {
+				vals = append(vals, int(item))
+			}
+			ps = append(ps, user.ScoreIn(vals...))
+		}
+		if v.GT != nil {
+			ps = append(ps, user.ScoreGT(int(*v.GT)))
+		}
+		if v.GTE != nil {
+			ps = append(ps, user.ScoreGTE(int(*v.GTE)))
+		}
+		if v.LT != nil {
+			ps = append(ps, user.ScoreLT(int(*v.LT)))
+		}
+		if v.LTE != nil {
+			ps = append(ps, user.ScoreLTE(int(*v.LTE)))
+		}
+	}
+	if v := f.IsVerified; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, user.IsVerifiedEQ(*v.EQ))
+		}
+	}
+	if v := f.TestUUID; v != nil {
+		if v.EQ != nil {
+			val, err := uuid.Parse(*v.EQ)
+			if err != nil {
+				return nil, invalidArgument("User", fmt.Errorf("invalid UUID for test_uuid: %w", err))
+			}
+			ps = append(ps, user.TestUUIDEQ(val))
+		}
+		if len(v.In) > 0 {
+			vals := make([]uuid.UUID, 0, len(v.In))
+			for _, item := range v.In {
+				val, err := uuid.Parse(item)
+				if err != nil {
+					return nil, invalidArgument("User", fmt.Errorf("invalid UUID for test_uuid: %w", err))
+				}
+				vals = append(vals, val)
+			}
+			ps = append(ps, user.TestUUIDIn(vals...))
+		}
+	}
+	if v := f.TestNillableUUID; v != nil {
+		if v.EQ != nil {
+			val, err := uuid.Parse(*v.EQ)
+			if err != nil {
+				return nil, invalidArgument("User", fmt.Errorf("invalid UUID for test_nillable_uuid: %w", err))
+			}
+			ps = append(ps, user.TestNillableUUIDEQ(val))
+		}
+		if len(v.In) > 0 {
+			vals := make([]uuid.UUID, 0, len(v.In))
+			for _, item := range v.In {
+				val, err := uuid.Parse(item)
+				if err != nil {
+					return nil, invalidArgument("User", fmt.Errorf("invalid UUID for test_nillable_uuid: %w", err))
+				}
+				vals = append(vals, val)
+			}
+			ps = append(ps, user.TestNillableUUIDIn(vals...))
+		}
+	}
+	if v := f.Status; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, user.StatusEQ(BizUserStatusToEnt(*v.EQ)))
+		}
+		if len(v.In) > 0 {
+			vals := make([]user.Status, 0, len(v.In))
+			for _, item := range v.In {
+				vals = append(vals, BizUserStatusToEnt(item))
+			}
+			ps = append(ps, user.StatusIn(vals...))
+		}
+	}
+	if v := f.Role; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, user.RoleEQ(*v.EQ))
+		}
+		if len(v.In) > 0 {
+			ps = append(ps, user.RoleIn(v.In...))
+		}
+	}
+	if v := f.Version; v != nil {
+		if v.EQ != nil {
+			ps = append(ps, user.VersionEQ(*v.EQ))
+		}
+		if len(v.In) > 0 {
+			ps = append(ps, user.VersionIn(v.In...))
+		}
+		if v.GT != nil {
+			ps = append(ps, user.VersionGT(*v.GT))
+		}
+		if v.GTE != nil {
+			ps = append(ps, user.VersionGTE(*v.GTE))
+		}
+		if v.LT != nil {
+			ps = append(ps, user.VersionLT(*v.LT))
+		}
+		if v.LTE != nil {
+			ps = append(ps, user.VersionLTE(*v.LTE))
+		}
+	}
+	return ps, nil
+}
+
+// ListUserPage 按 opts 对 q 进行过滤、排序与游标分页，返回当前页与下一页的游标 (没有更多数据时为空)
+func ListUserPage(ctx context.Context, q *ent.UserQuery, opts *biz.UserListOptions) ([]*ent.User, string, error) {
+	if opts == nil {
+		opts = &biz.UserListOptions{}
+	}
+	if !opts.IncludeDeleted {
+		q = q.Where(user.DeletedAtIsNil())
+	}
+	ps, err := UserFilterPredicates(opts.Filter)
+	if err != nil {
+		return nil, "", err
+	}
+	q = q.Where(ps...)
+	order := ent.Asc
+	if opts.Desc {
+		order = ent.Desc
+	}
+	var cursor func(e *ent.User) (string, error)
+	switch opts.OrderBy {
+	case biz.UserOrderByID:
+		if opts.PageToken != "" {
+			c, err := decodePageCursor[any, uuid.UUID](opts.PageToken)
+			if err != nil {
+				return nil, "", invalidArgument("User", err)
+			}
+			if opts.Desc {
+				q = q.Where(user.IDLT(c.ID))
+			} else {
+				q = q.Where(user.IDGT(c.ID))
+			}
+		}
+		q = q.Order(order(user.FieldID))
+		cursor = func(e *ent.User) (string, error) { return encodePageCursor(nil, e.ID) }
+	case biz.UserOrderByCreatedAt:
+		if opts.PageToken != "" {
+			c, err := decodePageCursor[time.Time, uuid.UUID](opts.PageToken)
+			if err != nil {
+				return nil, "", invalidArgument("User", err)
+			}
+			if opts.Desc {
+				q = q.Where(user.Or(user.CreatedAtLT(c.Value), user.And(user.CreatedAtEQ(c.Value), user.IDLT(c.ID))))
+			} else {
+				q = q.Where(user.Or(user.CreatedAtGT(c.Value), user.And(user.CreatedAtEQ(c.Value), user.IDGT(c.ID))))
+			}
+		}
+		q = q.Order(order(user.FieldCreatedAt, user.FieldID))
+		cursor = func(e *ent.User) (string, error) { return encodePageCursor(e.CreatedAt, e.ID) }
+	case biz.UserOrderByUpdatedAt:
+		if opts.PageToken != "" {
+			c, err := decodePageCursor[time.Time, uuid.UUID](opts.PageToken)
+			if err != nil {
+				return nil, "", invalidArgument("User", err)
+			}
+			if opts.Desc {
+				q = q.Where(user.Or(user.UpdatedAtLT(c.Value), user.And(user.UpdatedAtEQ(c.Value), user.IDLT(c.ID))))
+			} else {
+				q = q.Where(user.Or(user.UpdatedAtGT(c.Value), user.And(user.UpdatedAtEQ(c.Value), user.IDGT(c.ID))))
+			}
+		}
+		q = q.Order(order(user.FieldUpdatedAt, user.FieldID))
+		cursor = func(e *ent.User) (string, error) { return encodePageCursor(e.UpdatedAt, e.ID) }
+	case biz.UserOrderByName:
+		if opts.PageToken != "" {
+			c, err := decodePageCursor[string, uuid.UUID](opts.PageToken)
+			if err != nil {
+				return nil, "", invalidArgument("User", err)
+			}
+			if opts.Desc {
+				q = q.Where(user.Or(user.NameLT(c.Value), user.And(user.NameEQ(c.Value), user.IDLT(c.ID))))
+			} else {
+				q = q.Where(user.Or(user.NameGT(c.Value), user.And(user.NameEQ(c.Value), user.IDGT(c.ID))))
+			}
+		}
+		q = q.Order(order(user.FieldName, user.FieldID))
+		cursor = func(e *ent.User) (string, error) { return encodePageCursor(e.Name, e.ID) }
+	case biz.UserOrderByAge:
+		if opts.PageToken != "" {
+			c, err := decodePageCursor[int, uuid.UUID](opts.PageToken)
+			if err != nil {
+				return nil, "", invalidArgument("User", err)
+			}
+			if opts.Desc {
+				q = q.Where(user.Or(user.AgeLT(c.Value), user.And(user.AgeEQ(c.Value), user.IDLT(c.ID))))
+			} else {
+				q = q.Where(user.Or(user.AgeGT(c.Value), user.And(user.AgeEQ(c.Value), user.IDGT(c.ID))))
+			}
+		}
+		q = q.Order(order(user.FieldAge, user.FieldID))
+		cursor = func(e *ent.User) (string, error) { return encodePageCursor(e.Age, e.ID) }
+	case biz.UserOrderByVersion:
+		if opts.PageToken != "" {
+			c, err := decodePageCursor[int, uuid.UUID](opts.PageToken)
+			if err != nil {
+				return nil, "", invalidArgument("User", err)
+			}
+			if opts.Desc {
+				q = q.Where(user.Or(user.VersionLT(c.Value), user.And(user.VersionEQ(c.Value), user.IDLT(c.ID))))
+			} else {
+				q = q.Where(user.Or(user.VersionGT(c.Value), user.And(user.VersionEQ(c.Value), user.IDGT(c.ID))))
+			}
+		}
+		q = q.Order(order(user.FieldVersion, user.FieldID))
+		cursor = func(e *ent.User) (string, error) { return encodePageCursor(e.Version, e.ID) }
+	default:
+		return nil, "", invalidArgument("User", fmt.Errorf("ListUserPage: unknown order field %q", opts.OrderBy))
+	}
+	size := clampPageSize(opts.PageSize)
+	es, err := q.Limit(size + 1).All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(es) <= size {
+		return es, "", nil
+	}
+	es = es[:size]
+	next, err := cursor(es[size-1])
+	if err != nil {
+		return nil, "", err
+	}
+	return es, next, nil
+}
@@ -0,0 +1,48 @@
+package data_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/biz"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent"
+)
+
+// newClient opens an in-memory sqlite database private to the test, with the
+// schema migrated.
+func newClient(t *testing.T) *ent.Client {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", strings.ReplaceAll(t.Name(), "/", "_"))
+	c, err := ent.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	if err := c.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+	return c
+}
+
+type tenantKey struct{}
+
+func withTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// tenantFromContext is the biz.TenantResolver injected into the repositories.
+func tenantFromContext(ctx context.Context) (string, error) {
+	if t, ok := ctx.Value(tenantKey{}).(string); ok {
+		return t, nil
+	}
+	return "", biz.ErrNoTenant
+}
+
+func newUser(t *testing.T, c *ent.Client, name string) *ent.User {
+	t.Helper()
+	return c.User.Create().SetName(name).SetAge(20).SetStatus("ACTIVE").SaveX(context.Background())
+}
@@ -0,0 +1,66 @@
+package data_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/biz"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data"
+)
+
+// Edge counts are aggregated per user by grouped queries, counting only the
+// targets in scope: group memberships of the tenant and live friends.
+func TestEdgeCounts(t *testing.T) {
+	c := newClient(t)
+	ctx := withTenant(context.Background(), "t1")
+	repo := data.NewUserRepo(c, tenantFromContext)
+	bob, carol := newUser(t, c, "bob"), newUser(t, c, "carol")
+	gone := newUser(t, c, "gone")
+	alice := c.User.Create().SetName("alice").SetAge(20).SetStatus("ACTIVE").AddFriends(bob, carol, gone).SaveX(ctx)
+	c.Post.Create().SetTitle("a1").SetContent("").SetAuthor(alice).ExecX(ctx)
+	c.Post.Create().SetTitle("a2").SetContent("").SetAuthor(alice).ExecX(ctx)
+	c.Post.Create().SetTitle("b1").SetContent("").SetAuthor(bob).ExecX(ctx)
+	staff := c.Group.Create().SetTenantID("t1").SetName("staff").SaveX(ctx)
+	admins := c.Group.Create().SetTenantID("t1").SetName("admins").SaveX(ctx)
+	other := c.Group.Create().SetTenantID("t2").SetName("staff").SaveX(ctx)
+	c.Membership.Create().SetGroupID(staff.ID).SetUserID(alice.ID).ExecX(ctx)
+	c.Membership.Create().SetGroupID(admins.ID).SetUserID(alice.ID).ExecX(ctx)
+	c.Membership.Create().SetGroupID(other.ID).SetUserID(alice.ID).ExecX(ctx)
+	c.Membership.Create().SetGroupID(staff.ID).SetUserID(bob.ID).ExecX(ctx)
+	if err := repo.Delete(ctx, gone.ID.String()); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	list, _, err := repo.List(ctx, &biz.UserListOptions{})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	want := map[string][3]int{
+		alice.ID.String(): {2, 2, 2},
+		bob.ID.String():   {1, 1, 1},
+		carol.ID.String(): {0, 0, 1},
+	}
+	if len(list) != len(want) {
+		t.Fatalf("List: got %d users, want %d", len(list), len(want))
+	}
+	for _, u := range list {
+		if got := [3]int{u.PostCount, u.GroupCount, u.FriendCount}; got != want[u.UUID] {
+			t.Errorf("%s: got post, group, friend counts %v, want %v", u.Name, got, want[u.UUID])
+		}
+	}
+
+	// duplicate items share a count, nil items are skipped
+	items := []*biz.User{{}, nil, {}}
+	items[0].UUID, items[2].UUID = bob.ID.String(), bob.ID.String()
+	if err := data.FillUserPostCount(ctx, c, items, data.EdgeScope{}); err != nil {
+		t.Fatalf("FillUserPostCount: %v", err)
+	}
+	if items[0].PostCount != 1 || items[2].PostCount != 1 {
+		t.Errorf("duplicates: got post counts %d, %d, want 1, 1", items[0].PostCount, items[2].PostCount)
+	}
+	// group counts need a tenant
+	items[0].GroupCount = 5
+	if err := data.FillUserGroupCount(ctx, c, items[:1], data.EdgeScope{}); err != nil || items[0].GroupCount != 0 {
+		t.Errorf("no tenant: got group count %d, %v, want 0", items[0].GroupCount, err)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent/group"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent/membership"
 	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent/post"
 	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent/user"
 )
@@ -75,9 +76,10 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			group.Table: group.ValidColumn,
-			post.Table:  post.ValidColumn,
-			user.Table:  user.ValidColumn,
+			group.Table:      group.ValidColumn,
+			membership.Table: membership.ValidColumn,
+			post.Table:       post.ValidColumn,
+			user.Table:       user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -15,14 +15,18 @@ func main() {
 	opts := []entc.Option{
 		entc.Extensions(lazyent.NewExtension(
 			lazyent.Config{
-				ProtoOut:       "internal/tests/testenv/api/v1",
-				BizOut:         "internal/tests/testenv/app/user/internal/biz",
-				ServiceOut:     "internal/tests/testenv/app/user/internal/service",
-				DataOut:        "internal/tests/testenv/app/user/internal/data",
-				ProtoPackage:   "user.v1",
-				GoPackage:      "lazyent-test-app/user/v1;v1",
-				SingleFile:     true,
-				ProtoValidator: lazyent.ProtoValidatorPGV,
+				ProtoOut:            "internal/tests/testenv/api/v1",
+				BizOut:              "internal/tests/testenv/app/user/internal/biz",
+				ServiceOut:          "internal/tests/testenv/app/user/internal/service",
+				DataOut:             "internal/tests/testenv/app/user/internal/data",
+				ProtoPackage:        "user.v1",
+				GoPackage:           "lazyent-test-app/user/v1;v1",
+				SingleFile:          true,
+				ProtoValidator:      lazyent.ProtoValidatorPGV,
+				DefaultEdgeStrategy: lazyent.BizPointerWithProtoID, // Global default, overridden by schema/edge annotations
+				KeepSensitiveInBiz:  true,
+				ErrorReasons:        true,
+				WireProviders:       true,
 			},
 		)),
 	}
@@ -25,6 +25,8 @@ type Group struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GroupQuery when eager-loading is set.
 	Edges        GroupEdges `json:"edges"`
@@ -37,9 +39,13 @@ type GroupEdges struct {
 	Users []*User `json:"users,omitempty"`
 	// Admins holds the value of the admins edge.
 	Admins []*User `json:"admins,omitempty"`
+	// Moderators holds the value of the moderators edge.
+	Moderators []*User `json:"moderators,omitempty"`
+	// Memberships holds the value of the memberships edge.
+	Memberships []*Membership `json:"memberships,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // UsersOrErr returns the Users value or an error if the edge
@@ -60,12 +66,30 @@ func (e GroupEdges) AdminsOrErr() ([]*User, error) {
 	return nil, &NotLoadedError{edge: "admins"}
 }
 
+// ModeratorsOrErr returns the Moderators value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) ModeratorsOrErr() ([]*User, error) {
+	if e.loadedTypes[2] {
+		return e.Moderators, nil
+	}
+	return nil, &NotLoadedError{edge: "moderators"}
+}
+
+// MembershipsOrErr returns the Memberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) MembershipsOrErr() ([]*Membership, error) {
+	if e.loadedTypes[3] {
+		return e.Memberships, nil
+	}
+	return nil, &NotLoadedError{edge: "memberships"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Group) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case group.FieldName:
+		case group.FieldName, group.FieldTenantID:
 			values[i] = new(sql.NullString)
 		case group.FieldCreatedAt, group.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -110,6 +134,12 @@ func (_m *Group) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case group.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -133,6 +163,16 @@ func (_m *Group) QueryAdmins() *UserQuery {
 	return NewGroupClient(_m.config).QueryAdmins(_m)
 }
 
+// QueryModerators queries the "moderators" edge of the Group entity.
+func (_m *Group) QueryModerators() *UserQuery {
+	return NewGroupClient(_m.config).QueryModerators(_m)
+}
+
+// QueryMemberships queries the "memberships" edge of the Group entity.
+func (_m *Group) QueryMemberships() *MembershipQuery {
+	return NewGroupClient(_m.config).QueryMemberships(_m)
+}
+
 // Update returns a builder for updating this Group.
 // Note that you need to call Group.Unwrap() before calling this method if this Group
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -164,6 +204,9 @@ func (_m *Group) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -9,6 +9,9 @@ import (
 // Group is the predicate function for group builders.
 type Group func(*sql.Selector)
 
+// Membership is the predicate function for membership builders.
+type Membership func(*sql.Selector)
+
 // Post is the predicate function for post builders.
 type Post func(*sql.Selector)
 
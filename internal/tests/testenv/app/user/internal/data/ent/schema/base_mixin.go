@@ -30,11 +30,13 @@ func (BaseMixin) Fields() []ent.Field {
 		field.Time("created_at").
 			Immutable().
 			Default(time.Now).
-			Comment("创建时间"),
+			Comment("创建时间").
+			Annotations(lazyent.WithProtoReadOnly()),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now).
-			Comment("更新时间"),
+			Comment("更新时间").
+			Annotations(lazyent.WithProtoReadOnly()),
 	}
 }
 
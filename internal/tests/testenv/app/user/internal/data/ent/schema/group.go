@@ -2,8 +2,10 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	lazyent "github.com/Cromemadnd/lazyent/internal/types"
 )
 
@@ -15,24 +17,57 @@ type Group struct {
 // Fields of the Group.
 func (Group) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("name").NotEmpty().Unique().Annotations(lazyent.Annotation{
+		field.String("name").NotEmpty().Annotations(lazyent.Annotation{
 			ProtoValidation: "min_len:0",
 		}),
+		field.String("tenant_id").Immutable().Annotations(lazyent.Annotation{
+			Tenant: true, // Test tenant scoping from context
+		}),
+	}
+}
+
+// Indexes of the Group.
+func (Group) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").Unique(), // Test per-tenant unique key (FindByName scoped to the ctx tenant)
+	}
+}
+
+// Annotations of the Group.
+func (Group) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		lazyent.Annotation{
+			CRUDMethods: []lazyent.CRUDMethod{lazyent.CRUDCreate, lazyent.CRUDGet, lazyent.CRUDGetByUnique, lazyent.CRUDUpdate, lazyent.CRUDDelete, lazyent.CRUDList}, // Test tenant-scoped CRUD service (GetGroupByName)
+			RepoMethods: []lazyent.RepoMethod{ // Test tenant-scoped writes and partial repository (no ListAll/List)
+				lazyent.RepoSave, lazyent.RepoUpdate, lazyent.RepoDelete,
+				lazyent.RepoFindByID, lazyent.RepoFindByUnique, lazyent.RepoListEdges,
+			},
+		},
 	}
 }
 
 // Edges of the Group.
 func (Group) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("users", User.Type).Annotations(
-			lazyent.Annotation{
-				EdgeFieldStrategy: lazyent.BizPointerWithProtoMessage, // Default explicit
-			},
-		),
+		edge.To("users", User.Type).
+			Through("memberships", Membership.Type).
+			Annotations(
+				lazyent.Annotation{
+					EdgeFieldStrategy: lazyent.BizPointerWithProtoMessage, // Default explicit, overriding Config.DefaultEdgeStrategy
+					EdgeStrategySet:   true,
+					ThroughStrategy:   lazyent.ThroughAssociationList, // Test edge schema association
+					EdgeMethods:       lazyent.AllEdgeMethods,         // Test M2M edge sub-resources
+				},
+			),
 		edge.To("admins", User.Type).
 			Annotations(lazyent.Annotation{
 				EdgeFieldStrategy: lazyent.BizExcludeWithProtoExclude, // Completely hidden
 			}),
+		edge.To("moderators", User.Type).
+			Annotations(lazyent.Annotation{
+				EdgeFieldStrategy: lazyent.BizPointerWithProtoID, // Non-unique ProtoID (stubs in biz)
+				ProtoName:         "moderator_ids",
+			}),
 	}
 }
 
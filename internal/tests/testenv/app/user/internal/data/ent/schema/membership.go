@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/Cromemadnd/lazyent"
+	"github.com/google/uuid"
+)
+
+// Membership holds the schema definition for the Membership entity.
+// It is the edge schema of the Group.users <-> User.groups M2M relation.
+type Membership struct {
+	ent.Schema
+}
+
+// Fields of the Membership.
+func (Membership) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("role").
+			Values("UNSPECIFIED", "MEMBER", "OWNER").
+			Default("MEMBER").
+			Comment("成员角色"),
+		field.Time("joined_at").
+			Default(time.Now).
+			Comment("加入时间"),
+		field.UUID("user_id", uuid.UUID{}),
+		field.UUID("group_id", uuid.UUID{}),
+	}
+}
+
+// Edges of the Membership.
+func (Membership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Required().
+			Unique().
+			Field("user_id"),
+		edge.To("group", Group.Type).
+			Required().
+			Unique().
+			Field("group_id"),
+	}
+}
+
+// Annotations of the Membership.
+func (Membership) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// Schema-level default: edges keep biz pointers, proto already carries user_id/group_id
+		lazyent.WithEdgeFieldStrategy(lazyent.BizPointerWithProtoExclude),
+	}
+}
+
+func (Membership) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "group_id").Unique(),
+	}
+}
+
+func (Membership) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	lazyent "github.com/Cromemadnd/lazyent/internal/types"
@@ -35,6 +36,18 @@ func (Post) Edges() []ent.Edge {
 	}
 }
 
+// Annotations of the Post.
+var postMaxLen uint64 = 10000
+
+func (Post) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// Test child AIP resource, parent derived from the author edge
+		lazyent.Annotation{Resource: &lazyent.Resource{Type: "testenv.lazyent.dev/Post"}},
+		// Test schema-level default validation, applied to the string fields without their own rules
+		lazyent.Annotation{Validation: &lazyent.ValidationRules{String: &lazyent.StringRules{MaxLen: &postMaxLen}}},
+	}
+}
+
 func (Post) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		BaseMixin{},
@@ -2,8 +2,10 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/Cromemadnd/lazyent"
 	"github.com/Cromemadnd/lazyent/internal/tests/testenv/pkg/auth"
 	"github.com/google/uuid"
@@ -36,10 +38,16 @@ func (User) Fields() []ent.Field {
 			lazyent.WithBizName("UserScore"),
 			lazyent.WithProtoType("uint32"),
 			lazyent.WithProtoName("user_score"),
+			lazyent.WithVisibleTo(auth.RoleManager, auth.RoleLeader), // Test role-based visibility
 		)), // Nillable Int
 		field.Bool("is_verified").Default(false),                  // Bool
 		field.JSON("tags", []string{}).Optional().Comment("用户标签"), // JSON
-		field.String("password").Sensitive().Optional(),           // Sensitive
+		field.String("password").Sensitive().Optional(),           // Sensitive (kept in biz by Config.KeepSensitiveInBiz)
+		field.String("internal_note").Optional().
+			Annotations(lazyent.WithProtoExclude()).
+			Comment("内部备注"), // Biz only
+		field.String("search_vector").Optional().
+			Annotations(lazyent.WithBizExclude()), // Ent only
 		field.UUID("test_uuid", uuid.UUID{}).Default(uuid.New).Comment("测试UUID"),
 		field.UUID("test_nillable_uuid", uuid.UUID{}).Default(uuid.New).Nillable().Comment("测试UUID2"),
 		field.Enum("status").
@@ -48,6 +56,22 @@ func (User) Fields() []ent.Field {
 			GoType(auth.UserRole("")).
 			Default(string(auth.RoleUser)).
 			Comment("用户权限组"), // Custom Type Enum
+		field.Int("version").Default(0).
+			Annotations(lazyent.WithVersionField()).
+			Comment("乐观锁版本号"), // Optimistic concurrency
+	}
+}
+
+// Annotations of the User.
+func (User) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		lazyent.WithCRUDService(), // Test full CRUD service
+		lazyent.WithRepo(),        // Test full biz repository interface
+		lazyent.WithStream(),      // Test server-streaming export
+		lazyent.WithResource(&lazyent.Resource{ // Test root AIP resource, name clashes with the name field
+			Type:      "testenv.lazyent.dev/User",
+			NameField: "resource_name",
+		}),
 	}
 }
 
@@ -59,12 +83,23 @@ func (User) Edges() []ent.Edge {
 				lazyent.WithBizName("PostIDs"),
 				lazyent.WithProtoName("post_ids"),
 				lazyent.WithEdgeFieldStrategy(lazyent.BizIDWithProtoID), // Test BizIDOnly strategy
+				lazyent.WithEdgeCount(),                                 // Test O2M edge count (grouped aggregate)
+				lazyent.WithEdgeService(lazyent.EdgeList),               // Test paginated edge sub-resource
 			)),
 		edge.From("groups", Group.Type).
-			Ref("users"),
+			Ref("users").
+			Through("memberships", Membership.Type). // Plain M2M list (default ThroughStrategy)
+			Annotations(lazyent.MergeAnnotations(
+				lazyent.WithProtoName("group_ids"),      // Strategy falls back to Config.DefaultEdgeStrategy
+				lazyent.WithEdgeCount(),                 // Test edge schema M2M edge count (grouped aggregate)
+				lazyent.WithVisibleTo(auth.RoleManager), // Test role-based edge visibility
+			)),
 		edge.To("friends", User.Type). // Test Self-Reference
 						Annotations(
-				lazyent.WithEdgeFieldStrategy(lazyent.BizPointerWithProtoExclude), // Test ProtoExclude
+				lazyent.MergeAnnotations(
+					lazyent.WithEdgeFieldStrategy(lazyent.BizPointerWithProtoExclude), // Test ProtoExclude
+					lazyent.WithEdgeCount(), // Test plain M2M edge count (grouped join-table aggregate)
+				),
 			),
 	}
 }
@@ -72,5 +107,13 @@ func (User) Edges() []ent.Edge {
 func (User) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		BaseMixin{},
+		SoftDeleteMixin{}, // Test soft delete from a mixin field
+	}
+}
+
+// Indexes of the User.
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "age").Unique(), // Test composite unique key (FindByNameAndAge / GetUserByNameAndAge)
 	}
 }
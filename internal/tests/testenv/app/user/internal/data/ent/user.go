@@ -25,6 +25,8 @@ type User struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 更新时间
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Age holds the value of the "age" field.
@@ -39,6 +41,10 @@ type User struct {
 	Tags []string `json:"tags,omitempty"`
 	// Password holds the value of the "password" field.
 	Password string `json:"-"`
+	// 内部备注
+	InternalNote string `json:"internal_note,omitempty"`
+	// SearchVector holds the value of the "search_vector" field.
+	SearchVector string `json:"search_vector,omitempty"`
 	// 测试UUID
 	TestUUID uuid.UUID `json:"test_uuid,omitempty"`
 	// 测试UUID2
@@ -47,11 +53,14 @@ type User struct {
 	Status user.Status `json:"status,omitempty"`
 	// 用户权限组
 	Role auth.UserRole `json:"role,omitempty"`
+	// 乐观锁版本号
+	Version int `json:"version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
-	Edges        UserEdges `json:"edges"`
-	group_admins *uuid.UUID
-	selectValues sql.SelectValues
+	Edges            UserEdges `json:"edges"`
+	group_admins     *uuid.UUID
+	group_moderators *uuid.UUID
+	selectValues     sql.SelectValues
 }
 
 // UserEdges holds the relations/edges for other nodes in the graph.
@@ -62,9 +71,11 @@ type UserEdges struct {
 	Groups []*Group `json:"groups,omitempty"`
 	// Friends holds the value of the friends edge.
 	Friends []*User `json:"friends,omitempty"`
+	// Memberships holds the value of the memberships edge.
+	Memberships []*Membership `json:"memberships,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // PostsOrErr returns the Posts value or an error if the edge
@@ -94,6 +105,15 @@ func (e UserEdges) FriendsOrErr() ([]*User, error) {
 	return nil, &NotLoadedError{edge: "friends"}
 }
 
+// MembershipsOrErr returns the Memberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MembershipsOrErr() ([]*Membership, error) {
+	if e.loadedTypes[3] {
+		return e.Memberships, nil
+	}
+	return nil, &NotLoadedError{edge: "memberships"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -105,16 +125,18 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case user.FieldIsVerified:
 			values[i] = new(sql.NullBool)
-		case user.FieldAge, user.FieldScore:
+		case user.FieldAge, user.FieldScore, user.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case user.FieldName, user.FieldNickname, user.FieldPassword, user.FieldStatus, user.FieldRole:
+		case user.FieldName, user.FieldNickname, user.FieldPassword, user.FieldInternalNote, user.FieldSearchVector, user.FieldStatus, user.FieldRole:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case user.FieldID, user.FieldTestUUID:
 			values[i] = new(uuid.UUID)
 		case user.ForeignKeys[0]: // group_admins
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case user.ForeignKeys[1]: // group_moderators
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -148,6 +170,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case user.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Time
+			}
 		case user.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -192,6 +220,18 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Password = value.String
 			}
+		case user.FieldInternalNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internal_note", values[i])
+			} else if value.Valid {
+				_m.InternalNote = value.String
+			}
+		case user.FieldSearchVector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field search_vector", values[i])
+			} else if value.Valid {
+				_m.SearchVector = value.String
+			}
 		case user.FieldTestUUID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field test_uuid", values[i])
@@ -217,6 +257,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Role = auth.UserRole(value.String)
 			}
+		case user.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
 		case user.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field group_admins", values[i])
@@ -224,6 +270,13 @@ func (_m *User) assignValues(columns []string, values []any) error {
 				_m.group_admins = new(uuid.UUID)
 				*_m.group_admins = *value.S.(*uuid.UUID)
 			}
+		case user.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field group_moderators", values[i])
+			} else if value.Valid {
+				_m.group_moderators = new(uuid.UUID)
+				*_m.group_moderators = *value.S.(*uuid.UUID)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -252,6 +305,11 @@ func (_m *User) QueryFriends() *UserQuery {
 	return NewUserClient(_m.config).QueryFriends(_m)
 }
 
+// QueryMemberships queries the "memberships" edge of the User entity.
+func (_m *User) QueryMemberships() *MembershipQuery {
+	return NewUserClient(_m.config).QueryMemberships(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -281,6 +339,9 @@ func (_m *User) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(_m.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
@@ -301,6 +362,12 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("password=<sensitive>")
 	builder.WriteString(", ")
+	builder.WriteString("internal_note=")
+	builder.WriteString(_m.InternalNote)
+	builder.WriteString(", ")
+	builder.WriteString("search_vector=")
+	builder.WriteString(_m.SearchVector)
+	builder.WriteString(", ")
 	builder.WriteString("test_uuid=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TestUUID))
 	builder.WriteString(", ")
@@ -314,6 +381,9 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("role=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -67,6 +67,11 @@ func UpdatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldName, v))
@@ -97,6 +102,16 @@ func Password(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPassword, v))
 }
 
+// InternalNote applies equality check predicate on the "internal_note" field. It's identical to InternalNoteEQ.
+func InternalNote(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldInternalNote, v))
+}
+
+// SearchVector applies equality check predicate on the "search_vector" field. It's identical to SearchVectorEQ.
+func SearchVector(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSearchVector, v))
+}
+
 // TestUUID applies equality check predicate on the "test_uuid" field. It's identical to TestUUIDEQ.
 func TestUUID(v uuid.UUID) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldTestUUID, v))
@@ -107,6 +122,11 @@ func TestNillableUUID(v uuid.UUID) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldTestNillableUUID, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldVersion, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -187,6 +207,56 @@ func UpdatedAtLTE(v time.Time) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldName, v))
@@ -512,6 +582,156 @@ func PasswordContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldPassword, v))
 }
 
+// InternalNoteEQ applies the EQ predicate on the "internal_note" field.
+func InternalNoteEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldInternalNote, v))
+}
+
+// InternalNoteNEQ applies the NEQ predicate on the "internal_note" field.
+func InternalNoteNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldInternalNote, v))
+}
+
+// InternalNoteIn applies the In predicate on the "internal_note" field.
+func InternalNoteIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldInternalNote, vs...))
+}
+
+// InternalNoteNotIn applies the NotIn predicate on the "internal_note" field.
+func InternalNoteNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldInternalNote, vs...))
+}
+
+// InternalNoteGT applies the GT predicate on the "internal_note" field.
+func InternalNoteGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldInternalNote, v))
+}
+
+// InternalNoteGTE applies the GTE predicate on the "internal_note" field.
+func InternalNoteGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldInternalNote, v))
+}
+
+// InternalNoteLT applies the LT predicate on the "internal_note" field.
+func InternalNoteLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldInternalNote, v))
+}
+
+// InternalNoteLTE applies the LTE predicate on the "internal_note" field.
+func InternalNoteLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldInternalNote, v))
+}
+
+// InternalNoteContains applies the Contains predicate on the "internal_note" field.
+func InternalNoteContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldInternalNote, v))
+}
+
+// InternalNoteHasPrefix applies the HasPrefix predicate on the "internal_note" field.
+func InternalNoteHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldInternalNote, v))
+}
+
+// InternalNoteHasSuffix applies the HasSuffix predicate on the "internal_note" field.
+func InternalNoteHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldInternalNote, v))
+}
+
+// InternalNoteIsNil applies the IsNil predicate on the "internal_note" field.
+func InternalNoteIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldInternalNote))
+}
+
+// InternalNoteNotNil applies the NotNil predicate on the "internal_note" field.
+func InternalNoteNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldInternalNote))
+}
+
+// InternalNoteEqualFold applies the EqualFold predicate on the "internal_note" field.
+func InternalNoteEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldInternalNote, v))
+}
+
+// InternalNoteContainsFold applies the ContainsFold predicate on the "internal_note" field.
+func InternalNoteContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldInternalNote, v))
+}
+
+// SearchVectorEQ applies the EQ predicate on the "search_vector" field.
+func SearchVectorEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSearchVector, v))
+}
+
+// SearchVectorNEQ applies the NEQ predicate on the "search_vector" field.
+func SearchVectorNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldSearchVector, v))
+}
+
+// SearchVectorIn applies the In predicate on the "search_vector" field.
+func SearchVectorIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldSearchVector, vs...))
+}
+
+// SearchVectorNotIn applies the NotIn predicate on the "search_vector" field.
+func SearchVectorNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldSearchVector, vs...))
+}
+
+// SearchVectorGT applies the GT predicate on the "search_vector" field.
+func SearchVectorGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldSearchVector, v))
+}
+
+// SearchVectorGTE applies the GTE predicate on the "search_vector" field.
+func SearchVectorGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldSearchVector, v))
+}
+
+// SearchVectorLT applies the LT predicate on the "search_vector" field.
+func SearchVectorLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldSearchVector, v))
+}
+
+// SearchVectorLTE applies the LTE predicate on the "search_vector" field.
+func SearchVectorLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldSearchVector, v))
+}
+
+// SearchVectorContains applies the Contains predicate on the "search_vector" field.
+func SearchVectorContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldSearchVector, v))
+}
+
+// SearchVectorHasPrefix applies the HasPrefix predicate on the "search_vector" field.
+func SearchVectorHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldSearchVector, v))
+}
+
+// SearchVectorHasSuffix applies the HasSuffix predicate on the "search_vector" field.
+func SearchVectorHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldSearchVector, v))
+}
+
+// SearchVectorIsNil applies the IsNil predicate on the "search_vector" field.
+func SearchVectorIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldSearchVector))
+}
+
+// SearchVectorNotNil applies the NotNil predicate on the "search_vector" field.
+func SearchVectorNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldSearchVector))
+}
+
+// SearchVectorEqualFold applies the EqualFold predicate on the "search_vector" field.
+func SearchVectorEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldSearchVector, v))
+}
+
+// SearchVectorContainsFold applies the ContainsFold predicate on the "search_vector" field.
+func SearchVectorContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldSearchVector, v))
+}
+
 // TestUUIDEQ applies the EQ predicate on the "test_uuid" field.
 func TestUUIDEQ(v uuid.UUID) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldTestUUID, v))
@@ -642,6 +862,46 @@ func RoleNotIn(vs ...auth.UserRole) predicate.User {
 	return predicate.User(sql.FieldNotIn(FieldRole, v...))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldVersion, v))
+}
+
 // HasPosts applies the HasEdge predicate on the "posts" edge.
 func HasPosts() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -711,6 +971,29 @@ func HasFriendsWith(preds ...predicate.User) predicate.User {
 	})
 }
 
+// HasMemberships applies the HasEdge predicate on the "memberships" edge.
+func HasMemberships() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MembershipsTable, MembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMembershipsWith applies the HasEdge predicate on the "memberships" edge with a given conditions (other predicates).
+func HasMembershipsWith(preds ...predicate.Membership) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMembershipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
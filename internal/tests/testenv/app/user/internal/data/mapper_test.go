@@ -0,0 +1,70 @@
+package data_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+
+	pb "github.com/Cromemadnd/lazyent/internal/tests/testenv/api/v1"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/biz"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data"
+)
+
+// An unset unique edge is left out of the ent entity and leaves the stored
+// edge unchanged on update.
+func TestUniqueEdgeUnset(t *testing.T) {
+	c := newClient(t)
+	ctx := context.Background()
+	u := newUser(t, c, "alice")
+	p := c.Post.Create().SetTitle("hello").SetContent("").SetAuthor(u).SaveX(ctx)
+	b := &biz.Post{}
+	b.UUID, b.Title = p.ID.String(), "bye"
+	e, err := data.BizPostToEnt(b)
+	if err != nil || e.Edges.Author != nil {
+		t.Errorf("BizPostToEnt: got %v, %v, want no author", e, err)
+	}
+	upd, err := data.BuildPostUpdate(c.Post.UpdateOneID(p.ID), b)
+	if err != nil {
+		t.Fatalf("BuildPostUpdate: %v", err)
+	}
+	upd.ExecX(ctx)
+	if got := c.Post.GetX(ctx, p.ID).QueryAuthor().OnlyIDX(ctx); got != u.ID {
+		t.Errorf("author changed to %v", got)
+	}
+}
+
+// Updates leave UUID fields without a value unchanged, and reject mask paths
+// of edges owned by other entities as invalid arguments.
+func TestUpdateMask(t *testing.T) {
+	c := newClient(t)
+	ctx := context.Background()
+	repo := data.NewUserRepo(c, tenantFromContext)
+	u := newUser(t, c, "alice")
+
+	for _, mask := range [][]string{nil, {"test_nillable_uuid"}} {
+		b, err := repo.FindByID(ctx, u.ID.String())
+		if err != nil {
+			t.Fatalf("FindByID: %v", err)
+		}
+		b.TestNillableUUID = ""
+		if _, err := repo.Update(ctx, b, mask); err != nil {
+			t.Fatalf("Update with mask %v: %v", mask, err)
+		}
+		if got := c.User.GetX(ctx, u.ID).TestNillableUUID; got == nil || *got != *u.TestNillableUUID {
+			t.Errorf("mask %v: test_nillable_uuid changed to %v", mask, got)
+		}
+	}
+
+	b, err := repo.FindByID(ctx, u.ID.String())
+	if err != nil {
+		t.Fatalf("FindByID: %v", err)
+	}
+	for _, path := range []string{"post_ids", "group_ids"} {
+		_, err := repo.Update(ctx, b, []string{path})
+		if kerrors.Code(err) != http.StatusBadRequest || kerrors.Reason(err) != pb.ErrorReason_USER_INVALID_ARGUMENT.String() {
+			t.Errorf("mask %s: got %v, want bad request", path, err)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+// 该文件仅生成一次，可以在此实现自定义仓储方法。
+package data
+
+import (
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/biz"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent"
+)
+
+// NewGroupRepo 创建基于 ent 的 biz.GroupRepo，biz.GroupRepoExt 中声明的方法在此文件中实现。
+func NewGroupRepo(client *ent.Client, tenant biz.TenantResolver) biz.GroupRepo {
+	return &groupRepo{client: client, tenant: tenant}
+}
+
+// NewUserRepo 创建基于 ent 的 biz.UserRepo，biz.UserRepoExt 中声明的方法在此文件中实现。
+func NewUserRepo(client *ent.Client, tenant biz.TenantResolver) biz.UserRepo {
+	return &userRepo{client: client, tenant: tenant}
+}
@@ -0,0 +1,769 @@
+// Code generated by lazyent. DO NOT EDIT.
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+
+	pb "github.com/Cromemadnd/lazyent/internal/tests/testenv/api/v1"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/biz"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent/group"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent/membership"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent/post"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent/user"
+)
+
+// entErrorReason 是实体的 ErrorReason，Unique 为其各唯一约束冲突时的 ErrorReason
+type entErrorReason struct {
+	NotFound pb.ErrorReason
+	Conflict pb.ErrorReason
+	Unique   []uniqueErrorReason
+	// ConcurrentModification 仅对有版本字段的实体有效
+	ConcurrentModification pb.ErrorReason
+}
+
+// uniqueErrorReason 是唯一约束冲突时的 ErrorReason，Markers 为该约束在各数据库驱动错误信息中的标识
+type uniqueErrorReason struct {
+	Reason  pb.ErrorReason
+	Markers []string
+}
+
+// entErrorReasons 按实体名索引 entErrorReason
+var entErrorReasons = map[string]entErrorReason{
+	"Group": {
+		NotFound: pb.ErrorReason_GROUP_NOT_FOUND,
+		Conflict: pb.ErrorReason_GROUP_CONFLICT,
+		Unique: []uniqueErrorReason{
+			{Reason: pb.ErrorReason_GROUP_TENANT_ID_AND_NAME_CONFLICT, Markers: []string{"groups.tenant_id, groups.name", "group_tenant_id_name"}},
+		},
+	},
+	"Membership": {
+		NotFound: pb.ErrorReason_MEMBERSHIP_NOT_FOUND,
+		Conflict: pb.ErrorReason_MEMBERSHIP_CONFLICT,
+		Unique: []uniqueErrorReason{
+			{Reason: pb.ErrorReason_MEMBERSHIP_USER_ID_AND_GROUP_ID_CONFLICT, Markers: []string{"memberships.user_id, memberships.group_id", "membership_user_id_group_id"}},
+		},
+	},
+	"Post": {
+		NotFound: pb.ErrorReason_POST_NOT_FOUND,
+		Conflict: pb.ErrorReason_POST_CONFLICT,
+	},
+	"User": {
+		NotFound: pb.ErrorReason_USER_NOT_FOUND,
+		Conflict: pb.ErrorReason_USER_CONFLICT,
+		Unique: []uniqueErrorReason{
+			{Reason: pb.ErrorReason_USER_NAME_AND_AGE_CONFLICT, Markers: []string{"users.name, users.age", "user_name_age"}},
+		},
+		ConcurrentModification: pb.ErrorReason_USER_CONCURRENT_MODIFICATION,
+	},
+}
+
+// conflictReason 返回约束错误 err 的 ErrorReason: 错误信息匹配到唯一约束时返回标识最长的唯一约束的 ErrorReason，否则返回 Conflict
+func (r entErrorReason) conflictReason(err error) pb.ErrorReason {
+	msg, reason, best := err.Error(), r.Conflict, 0
+	for _, u := range r.Unique {
+		for _, m := range u.Markers {
+			if len(m) > best && strings.Contains(msg, m) {
+				reason, best = u.Reason, len(m)
+			}
+		}
+	}
+	return reason
+}
+
+// wrapEntError 将 ent 的 NotFound、约束与校验错误转换为携带 ErrorReason 的 Kratos 错误，
+// 其 cause 分别为 biz.NotFoundError、biz.ConflictError 与原始错误，errors.Is(err, biz.ErrNotFound) 等判断仍然成立；
+// 校验错误经由 invalidArgument 转换
+func wrapEntError(entity string, err error) error {
+	r := entErrorReasons[entity]
+	switch {
+	case ent.IsNotFound(err):
+		return kerrors.NotFound(r.NotFound.String(), entity+" not found").WithCause(&biz.NotFoundError{Entity: entity, Err: err})
+	case ent.IsConstraintError(err):
+		return kerrors.Conflict(r.conflictReason(err).String(), entity+" conflict").WithCause(&biz.ConflictError{Entity: entity, Err: err})
+	case ent.IsValidationError(err):
+		return invalidArgument(entity, err)
+	}
+	return err
+}
+
+// notFoundError 返回 entity 不存在时携带 ErrorReason 的 Kratos 错误，其 cause 为 biz.NotFoundError
+func notFoundError(entity string) error {
+	return kerrors.NotFound(entErrorReasons[entity].NotFound.String(), entity+" not found").WithCause(&biz.NotFoundError{Entity: entity})
+}
+
+// concurrentModificationError 返回版本不匹配时携带 ErrorReason 的 Kratos 错误，其 cause 为 biz.ConcurrentModificationError
+func concurrentModificationError(entity string) error {
+	return kerrors.Conflict(entErrorReasons[entity].ConcurrentModification.String(), entity+" concurrent modification").WithCause(&biz.ConcurrentModificationError{Entity: entity})
+}
+
+// txKey 是 ctx 中 *ent.Tx 的键
+type txKey struct{}
+
+// transaction 基于 ent.Tx 实现 biz.Transaction
+type transaction struct {
+	client *ent.Client
+}
+
+// NewTransaction 创建基于 client 的 biz.Transaction
+func NewTransaction(client *ent.Client) biz.Transaction {
+	return &transaction{client: client}
+}
+
+// InTx 在事务中执行 fn: fn 返回错误或 panic 时回滚，否则提交；ctx 已在事务中时直接加入该事务
+func (t *transaction) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*ent.Tx); ok {
+		return fn(ctx)
+	}
+	tx, err := t.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// tenantID 返回 resolve 给出的 ctx 所属租户的 ID，resolve 为 nil 时返回 biz.ErrNoTenant
+func tenantID(ctx context.Context, resolve biz.TenantResolver) (id string, err error) {
+	if resolve == nil {
+		return id, biz.ErrNoTenant
+	}
+	return resolve(ctx)
+}
+
+// txClient 返回 ctx 中事务的客户端，不在事务中时返回 client，手写的仓储方法也应通过它访问数据库
+func txClient(ctx context.Context, client *ent.Client) *ent.Client {
+	if tx, ok := ctx.Value(txKey{}).(*ent.Tx); ok {
+		return tx.Client()
+	}
+	return client
+}
+
+// EdgeScope 限定预加载与关联数量读取的关联实体，零值排除已软删除的关联实体，
+// 带有租户字段的关联实体仅在 HasTenant 时加载，且限定为 Tenant 租户
+type EdgeScope struct {
+	IncludeDeleted bool   // 是否包含已软删除的关联实体
+	HasTenant      bool   // 是否加载带有租户字段的关联实体
+	Tenant         string // 关联实体所属的租户
+}
+
+// edgeScope 返回限定为 ctx 所属租户的 EdgeScope，ctx 中没有租户时返回的 EdgeScope 不加载带有租户字段的关联实体
+func edgeScope(ctx context.Context, resolve biz.TenantResolver) (EdgeScope, error) {
+	tenant, err := tenantID(ctx, resolve)
+	if errors.Is(err, biz.ErrNoTenant) {
+		return EdgeScope{}, nil
+	}
+	if err != nil {
+		return EdgeScope{}, err
+	}
+	return EdgeScope{HasTenant: true, Tenant: tenant}, nil
+}
+
+// WithGroupEdges 按各 Edge 的 EdgeFieldStrategy 为 q 配置预加载: Biz 指针加载关联实体，Biz ID 仅查询关联 ID，Biz 排除的 Edge 不加载，
+// 关联实体的范围由 scope 限定
+func WithGroupEdges(q *ent.GroupQuery, scope EdgeScope) *ent.GroupQuery {
+	q.WithModerators(func(q *ent.UserQuery) {
+		if !scope.IncludeDeleted {
+			q.Where(user.DeletedAtIsNil())
+		}
+	})
+	q.WithMemberships(func(q *ent.MembershipQuery) {
+		if !scope.IncludeDeleted {
+			q.Where(membership.HasUserWith(user.DeletedAtIsNil()))
+		}
+		q.WithUser()
+	})
+	return q
+}
+
+// entGroupsToBiz 将 es 转换为 Biz 实体，并按 scope 填充关联数量字段
+func entGroupsToBiz(ctx context.Context, client *ent.Client, es []*ent.Group, scope EdgeScope) ([]*biz.Group, error) {
+	res := make([]*biz.Group, 0, len(es))
+	for _, e := range es {
+		v, err := EntGroupToBiz(e)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// WithMembershipEdges 按各 Edge 的 EdgeFieldStrategy 为 q 配置预加载: Biz 指针加载关联实体，Biz ID 仅查询关联 ID，Biz 排除的 Edge 不加载，
+// 关联实体的范围由 scope 限定
+func WithMembershipEdges(q *ent.MembershipQuery, scope EdgeScope) *ent.MembershipQuery {
+	q.WithUser(func(q *ent.UserQuery) {
+		if !scope.IncludeDeleted {
+			q.Where(user.DeletedAtIsNil())
+		}
+	})
+	if scope.HasTenant {
+		q.WithGroup(func(q *ent.GroupQuery) {
+			q.Where(group.TenantID(scope.Tenant))
+		})
+	}
+	return q
+}
+
+// entMembershipsToBiz 将 es 转换为 Biz 实体，并按 scope 填充关联数量字段
+func entMembershipsToBiz(ctx context.Context, client *ent.Client, es []*ent.Membership, scope EdgeScope) ([]*biz.Membership, error) {
+	res := make([]*biz.Membership, 0, len(es))
+	for _, e := range es {
+		v, err := EntMembershipToBiz(e)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// WithPostEdges 按各 Edge 的 EdgeFieldStrategy 为 q 配置预加载: Biz 指针加载关联实体，Biz ID 仅查询关联 ID，Biz 排除的 Edge 不加载，
+// 关联实体的范围由 scope 限定
+func WithPostEdges(q *ent.PostQuery, scope EdgeScope) *ent.PostQuery {
+	q.WithAuthor(func(q *ent.UserQuery) {
+		if !scope.IncludeDeleted {
+			q.Where(user.DeletedAtIsNil())
+		}
+	})
+	return q
+}
+
+// entPostsToBiz 将 es 转换为 Biz 实体，并按 scope 填充关联数量字段
+func entPostsToBiz(ctx context.Context, client *ent.Client, es []*ent.Post, scope EdgeScope) ([]*biz.Post, error) {
+	res := make([]*biz.Post, 0, len(es))
+	for _, e := range es {
+		v, err := EntPostToBiz(e)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// WithUserEdges 按各 Edge 的 EdgeFieldStrategy 为 q 配置预加载: Biz 指针加载关联实体，Biz ID 仅查询关联 ID，Biz 排除的 Edge 不加载，
+// 关联实体的范围由 scope 限定
+func WithUserEdges(q *ent.UserQuery, scope EdgeScope) *ent.UserQuery {
+	q.WithPosts(func(q *ent.PostQuery) {
+		q.Select(post.FieldID)
+	})
+	if scope.HasTenant {
+		q.WithGroups(func(q *ent.GroupQuery) {
+			q.Where(group.TenantID(scope.Tenant))
+		})
+	}
+	q.WithFriends(func(q *ent.UserQuery) {
+		if !scope.IncludeDeleted {
+			q.Where(user.DeletedAtIsNil())
+		}
+	})
+	return q
+}
+
+// entUsersToBiz 将 es 转换为 Biz 实体，并按 scope 填充关联数量字段
+func entUsersToBiz(ctx context.Context, client *ent.Client, es []*ent.User, scope EdgeScope) ([]*biz.User, error) {
+	res := make([]*biz.User, 0, len(es))
+	for _, e := range es {
+		v, err := EntUserToBiz(e)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	if err := FillUserPostCount(ctx, client, res, scope); err != nil {
+		return nil, err
+	}
+	if err := FillUserGroupCount(ctx, client, res, scope); err != nil {
+		return nil, err
+	}
+	if err := FillUserFriendCount(ctx, client, res, scope); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// groupRepo 基于 ent 实现 biz.GroupRepo，构造函数与 biz.GroupRepoExt 的方法由手写代码提供
+type groupRepo struct {
+	client *ent.Client
+	tenant biz.TenantResolver
+}
+
+// db 返回 ctx 中事务的客户端，不在事务中时返回 r.client
+func (r *groupRepo) db(ctx context.Context) *ent.Client {
+	return txClient(ctx, r.client)
+}
+
+// only 查询 q 匹配的唯一 Group，按 Edge 策略预加载关联
+func (r *groupRepo) only(ctx context.Context, q *ent.GroupQuery) (*biz.Group, error) {
+	var scope EdgeScope
+	e, err := WithGroupEdges(q, scope).Only(ctx)
+	if err != nil {
+		return nil, wrapEntError("Group", err)
+	}
+	res, err := entGroupsToBiz(ctx, r.db(ctx), []*ent.Group{e}, scope)
+	if err != nil {
+		return nil, err
+	}
+	return res[0], nil
+}
+
+func (r *groupRepo) Save(ctx context.Context, b *biz.Group) (*biz.Group, error) {
+	tenant, err := tenantID(ctx, r.tenant)
+	if err != nil {
+		return nil, err
+	}
+	m, err := BuildGroupCreate(r.db(ctx).Group, b)
+	if err != nil {
+		return nil, err
+	}
+	m.SetTenantID(tenant)
+	e, err := m.Save(ctx)
+	if err != nil {
+		return nil, wrapEntError("Group", err)
+	}
+	return r.only(ctx, r.db(ctx).Group.Query().Where(group.TenantID(tenant)).Where(group.ID(e.ID)))
+}
+
+func (r *groupRepo) Update(ctx context.Context, b *biz.Group, mask []string) (*biz.Group, error) {
+	if b == nil {
+		return nil, errors.New("groupRepo.Update: nil entity")
+	}
+	tenant, err := tenantID(ctx, r.tenant)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(b.UUID)
+	if err != nil {
+		return nil, invalidArgument("Group", fmt.Errorf("invalid UUID for id: %w", err))
+	}
+	u := r.db(ctx).Group.UpdateOneID(id)
+	u.Where(group.TenantID(tenant))
+	if len(mask) == 0 {
+		if _, err := BuildGroupUpdate(u, b); err != nil {
+			return nil, err
+		}
+	} else if err := ApplyGroupUpdate(u, b, mask); err != nil {
+		return nil, err
+	}
+	if err := u.Exec(ctx); err != nil {
+		return nil, wrapEntError("Group", err)
+	}
+	return r.only(ctx, r.db(ctx).Group.Query().Where(group.TenantID(tenant)).Where(group.ID(id)))
+}
+
+func (r *groupRepo) FindByID(ctx context.Context, id string) (*biz.Group, error) {
+	tenant, err := tenantID(ctx, r.tenant)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, invalidArgument("Group", fmt.Errorf("invalid UUID for id: %w", err))
+	}
+	return r.only(ctx, r.db(ctx).Group.Query().Where(group.TenantID(tenant)).Where(group.ID(uid)))
+}
+
+func (r *groupRepo) Delete(ctx context.Context, id string) error {
+	tenant, err := tenantID(ctx, r.tenant)
+	if err != nil {
+		return err
+	}
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return invalidArgument("Group", fmt.Errorf("invalid UUID for id: %w", err))
+	}
+	if err := r.db(ctx).Group.DeleteOneID(uid).Where(group.TenantID(tenant)).Exec(ctx); err != nil {
+		return wrapEntError("Group", err)
+	}
+	return nil
+}
+
+func (r *groupRepo) List(ctx context.Context, opts *biz.GroupListOptions) ([]*biz.Group, string, error) {
+	tenant, err := tenantID(ctx, r.tenant)
+	if err != nil {
+		return nil, "", err
+	}
+	var scope EdgeScope
+	es, next, err := ListGroupPage(ctx, WithGroupEdges(r.db(ctx).Group.Query().Where(group.TenantID(tenant)), scope), opts)
+	if err != nil {
+		return nil, "", wrapEntError("Group", err)
+	}
+	res, err := entGroupsToBiz(ctx, r.db(ctx), es, scope)
+	if err != nil {
+		return nil, "", err
+	}
+	return res, next, nil
+}
+
+func (r *groupRepo) FindByName(ctx context.Context, name string) (*biz.Group, error) {
+	tenant, err := tenantID(ctx, r.tenant)
+	if err != nil {
+		return nil, err
+	}
+	return r.only(ctx, r.db(ctx).Group.Query().Where(group.TenantID(tenant)).Where(group.NameEQ(name)))
+}
+
+func (r *groupRepo) ListGroupModerators(ctx context.Context, id string) ([]*biz.User, error) {
+	tenant, err := tenantID(ctx, r.tenant)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, invalidArgument("Group", fmt.Errorf("invalid UUID for id: %w", err))
+	}
+	scope, err := edgeScope(ctx, r.tenant)
+	if err != nil {
+		return nil, err
+	}
+	es, err := WithUserEdges(r.db(ctx).Group.Query().Where(group.TenantID(tenant)).Where(group.ID(uid)).QueryModerators().Where(user.DeletedAtIsNil()), scope).All(ctx)
+	if err != nil {
+		return nil, wrapEntError("User", err)
+	}
+	return entUsersToBiz(ctx, r.db(ctx), es, scope)
+}
+
+func (r *groupRepo) ListGroupUsers(ctx context.Context, id string, opts *biz.UserListOptions) ([]*biz.User, string, error) {
+	tenant, err := tenantID(ctx, r.tenant)
+	if err != nil {
+		return nil, "", err
+	}
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, "", invalidArgument("Group", fmt.Errorf("invalid UUID for id: %w", err))
+	}
+	scope, err := edgeScope(ctx, r.tenant)
+	if err != nil {
+		return nil, "", err
+	}
+	if opts != nil {
+		scope.IncludeDeleted = opts.IncludeDeleted
+	}
+	es, next, err := ListUserPage(ctx, WithUserEdges(r.db(ctx).Group.Query().Where(group.TenantID(tenant)).Where(group.ID(uid)).QueryUsers(), scope), opts)
+	if err != nil {
+		return nil, "", wrapEntError("User", err)
+	}
+	res, err := entUsersToBiz(ctx, r.db(ctx), es, scope)
+	if err != nil {
+		return nil, "", err
+	}
+	return res, next, nil
+}
+
+func (r *groupRepo) AddGroupUsers(ctx context.Context, id string, ids []string) error {
+	tenant, err := tenantID(ctx, r.tenant)
+	if err != nil {
+		return err
+	}
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return invalidArgument("Group", fmt.Errorf("invalid UUID for id: %w", err))
+	}
+	tids := make([]uuid.UUID, 0, len(ids))
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, v := range ids {
+		tid, err := uuid.Parse(v)
+		if err != nil {
+			return invalidArgument("Group", fmt.Errorf("invalid UUID for id: %w", err))
+		}
+		if seen[tid] {
+			continue
+		}
+		seen[tid] = true
+		tids = append(tids, tid)
+	}
+	if _, err := r.db(ctx).Group.Query().Where(group.TenantID(tenant)).Where(group.ID(uid)).OnlyID(ctx); err != nil {
+		return wrapEntError("Group", err)
+	}
+	n, err := r.db(ctx).User.Query().Where(user.IDIn(tids...), user.DeletedAtIsNil()).Count(ctx)
+	if err != nil {
+		return wrapEntError("User", err)
+	}
+	if n != len(tids) {
+		return notFoundError("User")
+	}
+	builders := make([]*ent.MembershipCreate, 0, len(tids))
+	for _, tid := range tids {
+		builders = append(builders, r.db(ctx).Membership.Create().SetGroupID(uid).SetUserID(tid))
+	}
+	if err := r.db(ctx).Membership.CreateBulk(builders...).Exec(ctx); err != nil {
+		return wrapEntError("Membership", err)
+	}
+	return nil
+}
+
+func (r *groupRepo) RemoveGroupUsers(ctx context.Context, id string, ids []string) error {
+	tenant, err := tenantID(ctx, r.tenant)
+	if err != nil {
+		return err
+	}
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return invalidArgument("Group", fmt.Errorf("invalid UUID for id: %w", err))
+	}
+	tids := make([]uuid.UUID, 0, len(ids))
+	for _, v := range ids {
+		tid, err := uuid.Parse(v)
+		if err != nil {
+			return invalidArgument("Group", fmt.Errorf("invalid UUID for id: %w", err))
+		}
+		tids = append(tids, tid)
+	}
+	if err := r.db(ctx).Group.UpdateOneID(uid).Where(group.TenantID(tenant)).RemoveUserIDs(tids...).Exec(ctx); err != nil {
+		return wrapEntError("Group", err)
+	}
+	return nil
+}
+
+// userRepo 基于 ent 实现 biz.UserRepo，构造函数与 biz.UserRepoExt 的方法由手写代码提供
+type userRepo struct {
+	client *ent.Client
+	tenant biz.TenantResolver
+}
+
+// db 返回 ctx 中事务的客户端，不在事务中时返回 r.client
+func (r *userRepo) db(ctx context.Context) *ent.Client {
+	return txClient(ctx, r.client)
+}
+
+// only 查询 q 匹配的唯一 User，按 Edge 策略预加载关联
+func (r *userRepo) only(ctx context.Context, q *ent.UserQuery) (*biz.User, error) {
+	scope, err := edgeScope(ctx, r.tenant)
+	if err != nil {
+		return nil, err
+	}
+	e, err := WithUserEdges(q, scope).Only(ctx)
+	if err != nil {
+		return nil, wrapEntError("User", err)
+	}
+	res, err := entUsersToBiz(ctx, r.db(ctx), []*ent.User{e}, scope)
+	if err != nil {
+		return nil, err
+	}
+	return res[0], nil
+}
+
+func (r *userRepo) Save(ctx context.Context, b *biz.User) (*biz.User, error) {
+	m, err := BuildUserCreate(r.db(ctx).User, b)
+	if err != nil {
+		return nil, err
+	}
+	e, err := m.Save(ctx)
+	if err != nil {
+		return nil, wrapEntError("User", err)
+	}
+	return r.only(ctx, r.db(ctx).User.Query().Where(user.DeletedAtIsNil()).Where(user.ID(e.ID)))
+}
+
+func (r *userRepo) Update(ctx context.Context, b *biz.User, mask []string) (*biz.User, error) {
+	if b == nil {
+		return nil, errors.New("userRepo.Update: nil entity")
+	}
+	id, err := uuid.Parse(b.UUID)
+	if err != nil {
+		return nil, invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
+	}
+	u := r.db(ctx).User.UpdateOneID(id)
+	u.Where(user.DeletedAtIsNil())
+	if len(mask) == 0 {
+		if _, err := BuildUserUpdate(u, b); err != nil {
+			return nil, err
+		}
+	} else if err := ApplyUserUpdate(u, b, mask); err != nil {
+		return nil, err
+	}
+	// 仅当版本仍为 b.Version 时更新，并递增版本
+	u.Where(user.Version(b.Version)).AddVersion(1)
+	if err := u.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			exists, xerr := r.db(ctx).User.Query().Where(user.DeletedAtIsNil()).Where(user.ID(id)).Exist(ctx)
+			if xerr != nil {
+				return nil, wrapEntError("User", xerr)
+			}
+			if exists {
+				return nil, concurrentModificationError("User")
+			}
+		}
+		return nil, wrapEntError("User", err)
+	}
+	return r.only(ctx, r.db(ctx).User.Query().Where(user.DeletedAtIsNil()).Where(user.ID(id)))
+}
+
+func (r *userRepo) FindByID(ctx context.Context, id string) (*biz.User, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
+	}
+	return r.only(ctx, r.db(ctx).User.Query().Where(user.DeletedAtIsNil()).Where(user.ID(uid)))
+}
+
+func (r *userRepo) Delete(ctx context.Context, id string) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
+	}
+	if err := r.db(ctx).User.UpdateOneID(uid).Where(user.DeletedAtIsNil()).SetDeletedAt(time.Now()).Exec(ctx); err != nil {
+		return wrapEntError("User", err)
+	}
+	return nil
+}
+
+func (r *userRepo) Restore(ctx context.Context, id string) (*biz.User, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
+	}
+	if err := r.db(ctx).User.UpdateOneID(uid).Where(user.DeletedAtNotNil()).ClearDeletedAt().Exec(ctx); err != nil {
+		return nil, wrapEntError("User", err)
+	}
+	return r.only(ctx, r.db(ctx).User.Query().Where(user.DeletedAtIsNil()).Where(user.ID(uid)))
+}
+
+func (r *userRepo) ListAll(ctx context.Context) ([]*biz.User, error) {
+	scope, err := edgeScope(ctx, r.tenant)
+	if err != nil {
+		return nil, err
+	}
+	es, err := WithUserEdges(r.db(ctx).User.Query().Where(user.DeletedAtIsNil()), scope).All(ctx)
+	if err != nil {
+		return nil, wrapEntError("User", err)
+	}
+	return entUsersToBiz(ctx, r.db(ctx), es, scope)
+}
+
+func (r *userRepo) List(ctx context.Context, opts *biz.UserListOptions) ([]*biz.User, string, error) {
+	scope, err := edgeScope(ctx, r.tenant)
+	if err != nil {
+		return nil, "", err
+	}
+	if opts != nil {
+		scope.IncludeDeleted = opts.IncludeDeleted
+	}
+	es, next, err := ListUserPage(ctx, WithUserEdges(r.db(ctx).User.Query(), scope), opts)
+	if err != nil {
+		return nil, "", wrapEntError("User", err)
+	}
+	res, err := entUsersToBiz(ctx, r.db(ctx), es, scope)
+	if err != nil {
+		return nil, "", err
+	}
+	return res, next, nil
+}
+
+func (r *userRepo) Stream(ctx context.Context, filter *biz.UserFilter, batchSize int) iter.Seq2[*biz.User, error] {
+	return func(yield func(*biz.User, error) bool) {
+		scope, err := edgeScope(ctx, r.tenant)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		ps, err := UserFilterPredicates(filter)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		size := clampPageSize(batchSize)
+		var last *ent.User
+		for {
+			q := r.db(ctx).User.Query().Where(user.DeletedAtIsNil()).Where(ps...)
+			if last != nil {
+				q = q.Where(user.IDGT(last.ID))
+			}
+			es, err := WithUserEdges(q, scope).Order(ent.Asc(user.FieldID)).Limit(size).All(ctx)
+			if err != nil {
+				yield(nil, wrapEntError("User", err))
+				return
+			}
+			res, err := entUsersToBiz(ctx, r.db(ctx), es, scope)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, b := range res {
+				if !yield(b, nil) {
+					return
+				}
+			}
+			if len(es) < size {
+				return
+			}
+			last = es[len(es)-1]
+		}
+	}
+}
+
+func (r *userRepo) FindByNameAndAge(ctx context.Context, name string, age int) (*biz.User, error) {
+	return r.only(ctx, r.db(ctx).User.Query().Where(user.DeletedAtIsNil()).Where(user.NameEQ(name), user.AgeEQ(age)))
+}
+
+func (r *userRepo) ListUserGroups(ctx context.Context, id string) ([]*biz.Group, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
+	}
+	scope, err := edgeScope(ctx, r.tenant)
+	if err != nil {
+		return nil, err
+	}
+	if !scope.HasTenant {
+		return nil, biz.ErrNoTenant
+	}
+	es, err := WithGroupEdges(r.db(ctx).User.Query().Where(user.DeletedAtIsNil()).Where(user.ID(uid)).QueryGroups().Where(group.TenantID(scope.Tenant)), scope).All(ctx)
+	if err != nil {
+		return nil, wrapEntError("Group", err)
+	}
+	return entGroupsToBiz(ctx, r.db(ctx), es, scope)
+}
+
+func (r *userRepo) ListUserFriends(ctx context.Context, id string) ([]*biz.User, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
+	}
+	scope, err := edgeScope(ctx, r.tenant)
+	if err != nil {
+		return nil, err
+	}
+	es, err := WithUserEdges(r.db(ctx).User.Query().Where(user.DeletedAtIsNil()).Where(user.ID(uid)).QueryFriends().Where(user.DeletedAtIsNil()), scope).All(ctx)
+	if err != nil {
+		return nil, wrapEntError("User", err)
+	}
+	return entUsersToBiz(ctx, r.db(ctx), es, scope)
+}
+
+func (r *userRepo) ListUserPosts(ctx context.Context, id string, opts *biz.PostListOptions) ([]*biz.Post, string, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, "", invalidArgument("User", fmt.Errorf("invalid UUID for id: %w", err))
+	}
+	var scope EdgeScope
+	es, next, err := ListPostPage(ctx, WithPostEdges(r.db(ctx).User.Query().Where(user.DeletedAtIsNil()).Where(user.ID(uid)).QueryPosts(), scope), opts)
+	if err != nil {
+		return nil, "", wrapEntError("Post", err)
+	}
+	res, err := entPostsToBiz(ctx, r.db(ctx), es, scope)
+	if err != nil {
+		return nil, "", err
+	}
+	return res, next, nil
+}
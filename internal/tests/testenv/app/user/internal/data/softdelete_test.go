@@ -0,0 +1,97 @@
+package data_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/biz"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data"
+)
+
+// Deleted users are hidden from reads, updates, edge traversals, preloaded
+// edges and edge counts until restored; listing may opt in to see them.
+func TestSoftDelete(t *testing.T) {
+	c := newClient(t)
+	ctx := withTenant(context.Background(), "t1")
+	repo := data.NewUserRepo(c, tenantFromContext)
+	groups := data.NewGroupRepo(c, tenantFromContext)
+	bob := newUser(t, c, "bob")
+	alice := c.User.Create().SetName("alice").SetAge(20).SetStatus("ACTIVE").AddFriends(bob).SaveX(ctx)
+	g := c.Group.Create().SetTenantID("t1").SetName("staff").AddModerators(bob).SaveX(ctx)
+	c.Membership.Create().SetGroupID(g.ID).SetUserID(alice.ID).ExecX(ctx)
+	c.Membership.Create().SetGroupID(g.ID).SetUserID(bob.ID).ExecX(ctx)
+	id := bob.ID.String()
+
+	if err := repo.Delete(ctx, id); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if c.User.GetX(ctx, bob.ID).DeletedAt.IsZero() {
+		t.Fatal("Delete did not set deleted_at")
+	}
+	if _, err := repo.FindByID(ctx, id); !errors.Is(err, biz.ErrNotFound) {
+		t.Errorf("FindByID: got %v, want not found", err)
+	}
+	if err := repo.Delete(ctx, id); !errors.Is(err, biz.ErrNotFound) {
+		t.Errorf("Delete again: got %v, want not found", err)
+	}
+	b := &biz.User{}
+	b.UUID, b.Name = id, "bobby"
+	if _, err := repo.Update(ctx, b, []string{"name"}); !errors.Is(err, biz.ErrNotFound) {
+		t.Errorf("Update: got %v, want not found", err)
+	}
+
+	names := func(us []*biz.User) []string {
+		var res []string
+		for _, u := range us {
+			res = append(res, u.Name)
+		}
+		return res
+	}
+	list, _, err := repo.List(ctx, &biz.UserListOptions{})
+	if err != nil || len(list) != 1 || list[0].Name != "alice" {
+		t.Errorf("List: got %v, %v, want alice", names(list), err)
+	}
+	list, _, err = repo.List(ctx, &biz.UserListOptions{IncludeDeleted: true})
+	if err != nil || len(list) != 2 {
+		t.Errorf("List including deleted: got %v, %v, want both users", names(list), err)
+	}
+	var streamed []*biz.User
+	for u, err := range repo.Stream(ctx, nil, 0) {
+		if err != nil {
+			t.Fatalf("Stream: %v", err)
+		}
+		streamed = append(streamed, u)
+	}
+	if len(streamed) != 1 {
+		t.Errorf("Stream: got %v, want alice", names(streamed))
+	}
+
+	got, err := repo.FindByID(ctx, alice.ID.String())
+	if err != nil {
+		t.Fatalf("FindByID: %v", err)
+	}
+	if len(got.Friends) != 0 || got.FriendCount != 0 {
+		t.Errorf("preloaded friends: got %v (count %d), want none", names(got.Friends), got.FriendCount)
+	}
+	if friends, err := repo.ListUserFriends(ctx, alice.ID.String()); err != nil || len(friends) != 0 {
+		t.Errorf("ListUserFriends: got %v, %v, want none", names(friends), err)
+	}
+	if got, err := groups.FindByID(ctx, g.ID.String()); err != nil || len(got.Moderators) != 0 || len(got.Memberships) != 1 {
+		t.Errorf("preloaded group edges: got %v, %v, want no moderators and one membership", got, err)
+	}
+	if members, _, err := groups.ListGroupUsers(ctx, g.ID.String(), &biz.UserListOptions{}); err != nil || len(members) != 1 {
+		t.Errorf("ListGroupUsers: got %v, %v, want alice", names(members), err)
+	}
+
+	if _, err := repo.Restore(ctx, alice.ID.String()); !errors.Is(err, biz.ErrNotFound) {
+		t.Errorf("Restore live user: got %v, want not found", err)
+	}
+	restored, err := repo.Restore(ctx, id)
+	if err != nil || restored.Name != "bob" {
+		t.Fatalf("Restore: got %v, %v, want bob", restored, err)
+	}
+	if got, err := repo.FindByID(ctx, alice.ID.String()); err != nil || got.FriendCount != 1 {
+		t.Errorf("after restore: got %v, %v, want one friend", got, err)
+	}
+}
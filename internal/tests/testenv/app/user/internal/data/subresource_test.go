@@ -0,0 +1,53 @@
+package data_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/biz"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data"
+)
+
+// AddGroupUsers links only existing, not deleted users, and reports failures
+// on the entity at fault.
+func TestAddGroupUsers(t *testing.T) {
+	c := newClient(t)
+	ctx := withTenant(context.Background(), "t1")
+	repo := data.NewGroupRepo(c, tenantFromContext)
+	g := c.Group.Create().SetTenantID("t1").SetName("staff").SaveX(ctx)
+	alice, bob := newUser(t, c, "alice"), newUser(t, c, "bob")
+	deleted := c.User.Create().SetName("carol").SetAge(20).SetStatus("ACTIVE").SetDeletedAt(time.Now()).SaveX(ctx)
+
+	notFound := func(entity string, err error) bool {
+		var e *biz.NotFoundError
+		return errors.As(err, &e) && e.Entity == entity
+	}
+	if err := repo.AddGroupUsers(ctx, g.ID.String(), []string{alice.ID.String(), uuid.NewString()}); !notFound("User", err) {
+		t.Errorf("missing user: got %v, want User not found", err)
+	}
+	if err := repo.AddGroupUsers(ctx, g.ID.String(), []string{deleted.ID.String()}); !notFound("User", err) {
+		t.Errorf("deleted user: got %v, want User not found", err)
+	}
+	if err := repo.AddGroupUsers(ctx, uuid.NewString(), []string{alice.ID.String()}); !notFound("Group", err) {
+		t.Errorf("missing group: got %v, want Group not found", err)
+	}
+	if n := c.Membership.Query().CountX(ctx); n != 0 {
+		t.Fatalf("failed adds created %d memberships", n)
+	}
+
+	if err := repo.AddGroupUsers(ctx, g.ID.String(), []string{alice.ID.String(), bob.ID.String(), alice.ID.String()}); err != nil {
+		t.Fatalf("AddGroupUsers: %v", err)
+	}
+	if n := c.Membership.Query().CountX(ctx); n != 2 {
+		t.Errorf("got %d memberships, want 2", n)
+	}
+	var conflict *biz.ConflictError
+	err := repo.AddGroupUsers(ctx, g.ID.String(), []string{alice.ID.String()})
+	if !errors.As(err, &conflict) || conflict.Entity != "Membership" {
+		t.Errorf("existing member: got %v, want Membership conflict", err)
+	}
+}
@@ -0,0 +1,95 @@
+package data_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/biz"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data"
+)
+
+// Every read and write of a tenant type is limited to the tenant of the
+// context, which is required, and names are only unique within a tenant.
+func TestTenantIsolation(t *testing.T) {
+	ctx := context.Background()
+	t1, t2 := withTenant(ctx, "t1"), withTenant(ctx, "t2")
+	repo := data.NewGroupRepo(newClient(t), tenantFromContext)
+
+	a, err := repo.Save(t1, &biz.Group{GroupBase: biz.GroupBase{Name: "staff", TenantID: "t2"}})
+	if err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if a.TenantID != "t1" {
+		t.Errorf("Save took tenant %q from the entity, want the context tenant t1", a.TenantID)
+	}
+	b, err := repo.Save(t2, &biz.Group{GroupBase: biz.GroupBase{Name: "staff"}})
+	if err != nil {
+		t.Fatalf("Save with a name taken in another tenant: %v", err)
+	}
+
+	if _, err := repo.FindByID(t2, a.UUID); !errors.Is(err, biz.ErrNotFound) {
+		t.Errorf("FindByID across tenants: got %v, want ErrNotFound", err)
+	}
+	if g, err := repo.FindByName(t2, "staff"); err != nil || g.UUID != b.UUID {
+		t.Errorf("FindByName: got %v, %v, want the group of t2", g, err)
+	}
+	a.Name = "renamed"
+	if _, err := repo.Update(t2, a, []string{"name"}); !errors.Is(err, biz.ErrNotFound) {
+		t.Errorf("Update across tenants: got %v, want ErrNotFound", err)
+	}
+	if err := repo.Delete(t2, a.UUID); !errors.Is(err, biz.ErrNotFound) {
+		t.Errorf("Delete across tenants: got %v, want ErrNotFound", err)
+	}
+	if g, err := repo.FindByID(t1, a.UUID); err != nil || g.Name != "staff" {
+		t.Errorf("group changed by another tenant: %v, %v", g, err)
+	}
+
+	if _, err := repo.FindByID(ctx, a.UUID); !errors.Is(err, biz.ErrNoTenant) {
+		t.Errorf("FindByID without tenant: got %v, want ErrNoTenant", err)
+	}
+	if _, err := data.NewGroupRepo(newClient(t), nil).FindByID(t1, a.UUID); !errors.Is(err, biz.ErrNoTenant) {
+		t.Errorf("FindByID without resolver: got %v, want ErrNoTenant", err)
+	}
+}
+
+// Reads of a type without tenant field work without a tenant in the context,
+// leaving out their tenant edges, and only see the edges of the context
+// tenant otherwise. Traversals to a tenant type still require the tenant.
+func TestTenantEdges(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
+	g1 := c.Group.Create().SetTenantID("t1").SetName("a").SaveX(ctx)
+	g2 := c.Group.Create().SetTenantID("t2").SetName("b").SaveX(ctx)
+	u := newUser(t, c, "u")
+	c.Membership.Create().SetGroupID(g1.ID).SetUserID(u.ID).ExecX(ctx)
+	c.Membership.Create().SetGroupID(g2.ID).SetUserID(u.ID).ExecX(ctx)
+	repo := data.NewUserRepo(c, tenantFromContext)
+
+	got, err := repo.FindByID(withTenant(ctx, "t1"), u.ID.String())
+	if err != nil {
+		t.Fatalf("FindByID: %v", err)
+	}
+	if len(got.Groups) != 1 || got.Groups[0].UUID != g1.ID.String() || got.GroupCount != 1 {
+		t.Errorf("groups of t1: got %v (count %d), want only %s", got.Groups, got.GroupCount, g1.ID)
+	}
+
+	got, err = repo.FindByID(ctx, u.ID.String())
+	if err != nil {
+		t.Fatalf("FindByID without tenant: %v", err)
+	}
+	if len(got.Groups) != 0 || got.GroupCount != 0 {
+		t.Errorf("groups without tenant: got %v (count %d), want none", got.Groups, got.GroupCount)
+	}
+	if items, _, err := repo.List(ctx, nil); err != nil || len(items) != 1 {
+		t.Errorf("List without tenant: got %d items, %v", len(items), err)
+	}
+
+	if _, err := repo.ListUserGroups(ctx, u.ID.String()); !errors.Is(err, biz.ErrNoTenant) {
+		t.Errorf("ListUserGroups without tenant: got %v, want ErrNoTenant", err)
+	}
+	gs, err := repo.ListUserGroups(withTenant(ctx, "t2"), u.ID.String())
+	if err != nil || len(gs) != 1 || gs[0].UUID != g2.ID.String() {
+		t.Errorf("ListUserGroups of t2: got %v, %v, want only %s", gs, err, g2.ID)
+	}
+}
@@ -0,0 +1,54 @@
+package service_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+
+	pb "github.com/Cromemadnd/lazyent/internal/tests/testenv/api/v1"
+)
+
+// Malformed resource names, IDs and page tokens are bad requests carrying the
+// invalid argument reason of the entity.
+func TestInvalidArgument(t *testing.T) {
+	c := newClient(t)
+	ctx := context.Background()
+	users := newUserService(c, roleFromContext)
+	groups := newGroupService(c, roleFromContext)
+	g := c.Group.Create().SetTenantID("t1").SetName("staff").SaveX(ctx)
+
+	tests := []struct {
+		name   string
+		call   func() error
+		reason pb.ErrorReason
+	}{
+		{"resource name", func() error {
+			_, err := users.GetUser(ctx, &pb.GetUserRequest{ResourceName: "groups/1"})
+			return err
+		}, pb.ErrorReason_USER_INVALID_ARGUMENT},
+		{"resource name ID", func() error {
+			_, err := users.DeleteUser(ctx, &pb.DeleteUserRequest{ResourceName: "users/1"})
+			return err
+		}, pb.ErrorReason_USER_INVALID_ARGUMENT},
+		{"request ID", func() error {
+			_, err := groups.GetGroup(ctx, &pb.GetGroupRequest{Uuid: "1"})
+			return err
+		}, pb.ErrorReason_GROUP_INVALID_ARGUMENT},
+		{"target ID", func() error {
+			_, err := groups.AddGroupUsers(ctx, &pb.AddGroupUsersRequest{Uuid: g.ID.String(), UserIds: []string{"1"}})
+			return err
+		}, pb.ErrorReason_GROUP_INVALID_ARGUMENT},
+		{"page token", func() error {
+			_, err := users.ListUsers(ctx, &pb.ListUsersRequest{PageToken: "!"})
+			return err
+		}, pb.ErrorReason_USER_INVALID_ARGUMENT},
+	}
+	for _, tt := range tests {
+		err := tt.call()
+		if kerrors.Code(err) != http.StatusBadRequest || kerrors.Reason(err) != tt.reason.String() {
+			t.Errorf("%s: got %v, want bad request %s", tt.name, err, tt.reason)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+// 该文件仅生成一次，可以在此添加自定义业务逻辑。
+package service
+
+// GroupService 实现 pb.GroupServiceServer，嵌入了生成的 GroupServiceBase，可以在此覆盖其方法或添加新方法。
+type GroupService struct {
+	*GroupServiceBase
+}
+
+// NewGroupService 创建 GroupService
+func NewGroupService(uc GroupUsecase, role RoleResolver) *GroupService {
+	return &GroupService{GroupServiceBase: NewGroupServiceBase(uc, role)}
+}
@@ -0,0 +1,56 @@
+package service_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+
+	pb "github.com/Cromemadnd/lazyent/internal/tests/testenv/api/v1"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/biz"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/service"
+)
+
+// User RPCs address the user by its resource name users/{user}.
+func TestResourceNameRequests(t *testing.T) {
+	c := newClient(t)
+	ctx := context.Background()
+	users := newUserService(c, roleFromContext)
+	u := c.User.Create().SetName("alice").SetAge(20).SetStatus("ACTIVE").SaveX(ctx)
+	c.Post.Create().SetTitle("hello").SetContent("").SetAuthor(u).ExecX(ctx)
+	name := service.FormatUserResourceName(u.ID.String())
+	if name != "users/"+u.ID.String() {
+		t.Fatalf("FormatUserResourceName: got %q", name)
+	}
+
+	posts, err := users.ListUserPosts(ctx, &pb.ListUserPostsRequest{ResourceName: name})
+	if err != nil || len(posts.GetPosts()) != 1 || posts.GetPosts()[0].GetAuthor() != u.ID.String() {
+		t.Errorf("ListUserPosts: got %v, %v, want the post of alice", posts, err)
+	}
+	if _, err := users.DeleteUser(ctx, &pb.DeleteUserRequest{ResourceName: name}); err != nil {
+		t.Fatalf("DeleteUser: %v", err)
+	}
+	if _, err := users.GetUser(ctx, &pb.GetUserRequest{ResourceName: name}); kerrors.Code(err) != http.StatusNotFound {
+		t.Errorf("GetUser after delete: got %v, want not found", err)
+	}
+	got, err := users.RestoreUser(ctx, &pb.RestoreUserRequest{ResourceName: name})
+	if err != nil || got.GetUser().GetName() != "alice" {
+		t.Errorf("RestoreUser: got %v, %v, want alice", got, err)
+	}
+}
+
+// An unset unique edge maps between an empty proto ID and a nil biz entity.
+func TestUniqueEdgeUnset(t *testing.T) {
+	b, err := service.ProtoPostToBiz(&pb.Post{Title: "hello"})
+	if err != nil {
+		t.Fatalf("ProtoPostToBiz: %v", err)
+	}
+	if b.Author != nil {
+		t.Errorf("ProtoPostToBiz: got author %v, want nil", b.Author)
+	}
+	p, err := service.BizPostToProto(&biz.Post{})
+	if err != nil || p.GetAuthor() != "" {
+		t.Errorf("BizPostToProto: got author %q, %v, want empty", p.GetAuthor(), err)
+	}
+}
@@ -2,70 +2,371 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	pb "github.com/Cromemadnd/lazyent/internal/tests/testenv/api/v1"
 	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/biz"
 	"github.com/Cromemadnd/lazyent/internal/tests/testenv/pkg/auth"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// invalidArgumentReasons 按实体名索引参数无效时的 ErrorReason
+var invalidArgumentReasons = map[string]pb.ErrorReason{
+	"Group":      pb.ErrorReason_GROUP_INVALID_ARGUMENT,
+	"Membership": pb.ErrorReason_MEMBERSHIP_INVALID_ARGUMENT,
+	"Post":       pb.ErrorReason_POST_INVALID_ARGUMENT,
+	"User":       pb.ErrorReason_USER_INVALID_ARGUMENT,
+}
+
+// invalidArgument 将 entity 的参数错误 err (例如格式错误的 ID) 转换为携带 ErrorReason 的 Kratos BadRequest 错误，其 cause 为 err
+func invalidArgument(entity string, err error) error {
+	return kerrors.BadRequest(invalidArgumentReasons[entity].String(), err.Error()).WithCause(err)
+}
+
+// RoleResolver 返回 ctx 中调用方的角色，由应用提供并注入服务的构造函数，
+// 决定 WithVisibleTo 限定的字段是否可见；为 nil 时受限字段对所有调用方隐藏
+type RoleResolver func(ctx context.Context) string
+
+// callerRole 返回 resolve 给出的 ctx 中调用方的角色，resolve 为 nil 时返回空字符串
+func callerRole(ctx context.Context, resolve RoleResolver) string {
+	if resolve == nil {
+		return ""
+	}
+	return resolve(ctx)
+}
+
 func BizGroupToProto(b *biz.Group) (*pb.Group, error) {
 	if b == nil {
 		return nil, errors.New("BizGroupToProto: nil entity")
 	}
-	var users []*pb.User
-	for _, item := range b.Users {
-		v, err := BizUserToProto(item)
+
+	var moderatorIds []string
+	for _, item := range b.Moderators {
+		if item == nil {
+			continue
+		}
+		moderatorIds = append(moderatorIds, item.UUID)
+	}
+	var memberships []*pb.GroupMembership
+	for _, item := range b.Memberships {
+		v, err := BizGroupMembershipToProto(item)
 		if err != nil {
 			return nil, err
 		}
-		users = append(users, v)
+		memberships = append(memberships, v)
 	}
 	return &pb.Group{
-		Uuid:      b.UUID,
-		CreatedAt: timestamppb.New(b.CreatedAt),
-		UpdatedAt: timestamppb.New(b.UpdatedAt),
-		Name:      b.Name,
-		Users:     users,
+		Uuid:         b.UUID,
+		CreatedAt:    timestamppb.New(b.CreatedAt),
+		UpdatedAt:    timestamppb.New(b.UpdatedAt),
+		Name:         b.Name,
+		TenantId:     b.TenantID,
+		ModeratorIds: moderatorIds,
+		Memberships:  memberships,
 	}, nil
 }
 
+// redactGroupProto 清除 p 中角色 role 不可见的字段
+func redactGroupProto(role string, p *pb.Group) {
+	if p == nil {
+		return
+	}
+	for _, v := range p.Memberships {
+		redactUserProto(role, v.GetUser())
+	}
+}
+
+// BizGroupToProtoFor 同 BizGroupToProto，并清除角色 role 不可见的字段
+func BizGroupToProtoFor(role string, b *biz.Group) (*pb.Group, error) {
+	p, err := BizGroupToProto(b)
+	if err != nil {
+		return nil, err
+	}
+	redactGroupProto(role, p)
+	return p, nil
+}
+
 func ProtoGroupToBiz(p *pb.Group) (*biz.Group, error) {
 	if p == nil {
 		return nil, errors.New("ProtoGroupToBiz: nil entity")
 	}
-	var users []*biz.User
-	for _, item := range p.Users {
-		v, err := ProtoUserToBiz(item)
+	var moderators []*biz.User
+	for _, item := range p.ModeratorIds {
+		if _, err := uuid.Parse(item); err != nil {
+			return nil, invalidArgument("Group", fmt.Errorf("invalid UUID for moderators: %w", err))
+		}
+		moderators = append(moderators, biz.NewUserStub(item))
+	}
+	var memberships []*biz.GroupMembership
+	for _, item := range p.Memberships {
+		v, err := ProtoGroupMembershipToBiz(item)
 		if err != nil {
 			return nil, err
 		}
-		users = append(users, v)
+		memberships = append(memberships, v)
 	}
 	return &biz.Group{
 		GroupBase: biz.GroupBase{
-			UUID:      p.Uuid,
-			CreatedAt: p.CreatedAt.AsTime(),
-			UpdatedAt: p.UpdatedAt.AsTime(),
-			Name:      p.Name,
-			Users:     users,
+			UUID:        p.Uuid,
+			Name:        p.Name,
+			Moderators:  moderators,
+			Memberships: memberships,
 		},
 	}, nil
 }
 
+func ProtoCreateGroupRequestToBiz(p *pb.CreateGroupRequest) (*biz.Group, error) {
+	if p == nil {
+		return nil, errors.New("ProtoCreateGroupRequestToBiz: nil request")
+	}
+	b := &biz.Group{}
+	b.Name = p.Name
+	var moderators []*biz.User
+	for _, item := range p.ModeratorIds {
+		if _, err := uuid.Parse(item); err != nil {
+			return nil, invalidArgument("Group", fmt.Errorf("invalid UUID for moderators: %w", err))
+		}
+		moderators = append(moderators, biz.NewUserStub(item))
+	}
+	b.Moderators = moderators
+	return b, nil
+}
+
+func ProtoUpdateGroupRequestToBiz(p *pb.UpdateGroupRequest) (*biz.GroupPatch, error) {
+	if p == nil {
+		return nil, errors.New("ProtoUpdateGroupRequestToBiz: nil request")
+	}
+	patch := &biz.GroupPatch{UUID: p.Uuid}
+	if p.Name != nil {
+		patch.Name = p.Name
+	}
+	if len(p.ModeratorIds) > 0 {
+		for _, item := range p.ModeratorIds {
+			if _, err := uuid.Parse(item); err != nil {
+				return nil, invalidArgument("Group", fmt.Errorf("invalid UUID for moderators: %w", err))
+			}
+		}
+		patch.ModeratorIDs = p.ModeratorIds
+	}
+	return patch, nil
+}
+
+// ProtoUpdateGroupRequestMask 返回 UpdateGroupRequest 的更新路径：优先使用 update_mask，否则为请求中出现的字段
+func ProtoUpdateGroupRequestMask(p *pb.UpdateGroupRequest) []string {
+	if paths := p.GetUpdateMask().GetPaths(); len(paths) > 0 {
+		return paths
+	}
+	var paths []string
+	if p.Name != nil {
+		paths = append(paths, "name")
+	}
+	if len(p.ModeratorIds) > 0 {
+		paths = append(paths, "moderator_ids")
+	}
+	return paths
+}
+
+// ProtoGroupFilterToBiz 将 GroupFilter 转换为 biz.GroupFilter
+func ProtoGroupFilterToBiz(f *pb.GroupFilter) *biz.GroupFilter {
+	if f == nil {
+		return nil
+	}
+	res := &biz.GroupFilter{}
+	if v := f.GetUuid(); v != nil {
+		x := &biz.ValueFilter[string]{}
+		x.EQ = v.Eq
+		x.In = v.In
+		res.UUID = x
+	}
+	if v := f.GetCreatedAt(); v != nil {
+		x := &biz.RangeFilter[time.Time]{}
+		if v.Eq != nil {
+			val := v.Eq.AsTime()
+			x.EQ = &val
+		}
+		for _, item := range v.In {
+			x.In = append(x.In, item.AsTime())
+		}
+		if v.Gt != nil {
+			val := v.Gt.AsTime()
+			x.GT = &val
+		}
+		if v.Gte != nil {
+			val := v.Gte.AsTime()
+			x.GTE = &val
+		}
+		if v.Lt != nil {
+			val := v.Lt.AsTime()
+			x.LT = &val
+		}
+		if v.Lte != nil {
+			val := v.Lte.AsTime()
+			x.LTE = &val
+		}
+		res.CreatedAt = x
+	}
+	if v := f.GetUpdatedAt(); v != nil {
+		x := &biz.RangeFilter[time.Time]{}
+		if v.Eq != nil {
+			val := v.Eq.AsTime()
+			x.EQ = &val
+		}
+		for _, item := range v.In {
+			x.In = append(x.In, item.AsTime())
+		}
+		if v.Gt != nil {
+			val := v.Gt.AsTime()
+			x.GT = &val
+		}
+		if v.Gte != nil {
+			val := v.Gte.AsTime()
+			x.GTE = &val
+		}
+		if v.Lt != nil {
+			val := v.Lt.AsTime()
+			x.LT = &val
+		}
+		if v.Lte != nil {
+			val := v.Lte.AsTime()
+			x.LTE = &val
+		}
+		res.UpdatedAt = x
+	}
+	if v := f.GetName(); v != nil {
+		x := &biz.StringFilter{}
+		x.EQ = v.Eq
+		x.In = v.In
+		x.Contains = v.Contains
+		res.Name = x
+	}
+	return res
+}
+
+// ProtoGroupOrderByToBiz 将 GroupOrderBy 转换为 biz.GroupOrderField
+func ProtoGroupOrderByToBiz(o pb.GroupOrderBy) (biz.GroupOrderField, error) {
+	switch o {
+	case pb.GroupOrderBy_GROUPORDERBY_UNSPECIFIED:
+		return biz.GroupOrderByID, nil
+	case pb.GroupOrderBy_GROUPORDERBY_CREATED_AT:
+		return biz.GroupOrderByCreatedAt, nil
+	case pb.GroupOrderBy_GROUPORDERBY_UPDATED_AT:
+		return biz.GroupOrderByUpdatedAt, nil
+	case pb.GroupOrderBy_GROUPORDERBY_NAME:
+		return biz.GroupOrderByName, nil
+	default:
+		return "", invalidArgument("Group", fmt.Errorf("unknown order_by: %v", o))
+	}
+}
+
+// ProtoListGroupsRequestToBiz 将 ListGroupsRequest 转换为 biz.GroupListOptions
+func ProtoListGroupsRequestToBiz(p *pb.ListGroupsRequest) (*biz.GroupListOptions, error) {
+	orderBy, err := ProtoGroupOrderByToBiz(p.GetOrderBy())
+	if err != nil {
+		return nil, err
+	}
+	return &biz.GroupListOptions{
+		PageSize:  int(p.GetPageSize()),
+		PageToken: p.GetPageToken(),
+		Filter:    ProtoGroupFilterToBiz(p.GetFilter()),
+		OrderBy:   orderBy,
+		Desc:      p.GetDesc(),
+	}, nil
+}
+
+// ProtoListGroupUsersRequestToBiz 将 ListGroupUsersRequest 转换为 biz.UserListOptions
+func ProtoListGroupUsersRequestToBiz(p *pb.ListGroupUsersRequest) (*biz.UserListOptions, error) {
+	orderBy, err := ProtoUserOrderByToBiz(p.GetOrderBy())
+	if err != nil {
+		return nil, err
+	}
+	return &biz.UserListOptions{
+		PageSize:       int(p.GetPageSize()),
+		PageToken:      p.GetPageToken(),
+		Filter:         ProtoUserFilterToBiz(p.GetFilter()),
+		OrderBy:        orderBy,
+		Desc:           p.GetDesc(),
+		IncludeDeleted: p.GetIncludeDeleted(),
+	}, nil
+}
+
+func BizMembershipToProto(b *biz.Membership) (*pb.Membership, error) {
+	if b == nil {
+		return nil, errors.New("BizMembershipToProto: nil entity")
+	}
+	return &pb.Membership{
+		Uuid:      b.UUID,
+		CreatedAt: timestamppb.New(b.CreatedAt),
+		UpdatedAt: timestamppb.New(b.UpdatedAt),
+		Role:      BizMembershipRoleToProto(b.Role),
+		JoinedAt:  timestamppb.New(b.JoinedAt),
+		UserId:    b.UserID,
+		GroupId:   b.GroupID,
+	}, nil
+}
+
+func ProtoMembershipToBiz(p *pb.Membership) (*biz.Membership, error) {
+	if p == nil {
+		return nil, errors.New("ProtoMembershipToBiz: nil entity")
+	}
+	return &biz.Membership{
+		MembershipBase: biz.MembershipBase{
+			UUID:     p.Uuid,
+			Role:     ProtoMembershipRoleToBiz(p.Role),
+			JoinedAt: p.JoinedAt.AsTime(),
+			UserID:   p.UserId,
+			GroupID:  p.GroupId,
+		},
+	}, nil
+}
+
+// FormatPostResourceName 返回 Post 的资源名称 users/{user}/posts/{post}
+func FormatPostResourceName(userID, postID string) string {
+	return "users/" + userID + "/posts/" + postID
+}
+
+// ParsePostResourceName 解析 Post 的资源名称 users/{user}/posts/{post}
+func ParsePostResourceName(name string) (userID, postID string, err error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 4 || parts[0] != "users" || parts[1] == "" || parts[2] != "posts" || parts[3] == "" {
+		return "", "", invalidArgument("Post", fmt.Errorf("invalid Post resource name %q, want users/{user}/posts/{post}", name))
+	}
+	return parts[1], parts[3], nil
+}
+
+// bizPostResourceName 返回 b 的资源名称，b 未携带的父资源 ID 使用通配符 "-"
+func bizPostResourceName(b *biz.Post) string {
+	userID := "-"
+	if p := b.Author; p != nil {
+		if p.UUID != "" {
+			userID = p.UUID
+		}
+	}
+	return FormatPostResourceName(userID, b.UUID)
+}
+
 func BizPostToProto(b *biz.Post) (*pb.Post, error) {
 	if b == nil {
 		return nil, errors.New("BizPostToProto: nil entity")
 	}
 
+	var author string
+	if b.Author != nil {
+		author = b.Author.UUID
+	}
 	return &pb.Post{
-		Uuid:      b.UUID,
+		Name:      bizPostResourceName(b),
 		CreatedAt: timestamppb.New(b.CreatedAt),
 		UpdatedAt: timestamppb.New(b.UpdatedAt),
 		Title:     b.Title,
 		Content:   b.Content,
-		Author:    b.Author.UUID,
+		Author:    author,
 	}, nil
 }
 
@@ -73,18 +374,150 @@ func ProtoPostToBiz(p *pb.Post) (*biz.Post, error) {
 	if p == nil {
 		return nil, errors.New("ProtoPostToBiz: nil entity")
 	}
+	var resourceID string
+	if p.Name != "" {
+		var err error
+		if _, resourceID, err = ParsePostResourceName(p.Name); err != nil {
+			return nil, err
+		}
+	}
+	var author *biz.User
+	if p.Author != "" {
+		if _, err := uuid.Parse(p.Author); err != nil {
+			return nil, invalidArgument("Post", fmt.Errorf("invalid UUID for author: %w", err))
+		}
+		author = biz.NewUserStub(p.Author)
+	}
 	return &biz.Post{
 		PostBase: biz.PostBase{
-			UUID:      p.Uuid,
-			CreatedAt: p.CreatedAt.AsTime(),
-			UpdatedAt: p.UpdatedAt.AsTime(),
-			Title:     p.Title,
-			Content:   p.Content,
-			Author:    &biz.User{UserBase: biz.UserBase{UUID: p.Author}},
+			UUID:    resourceID,
+			Title:   p.Title,
+			Content: p.Content,
+			Author:  author,
 		},
 	}, nil
 }
 
+// ProtoPostFilterToBiz 将 PostFilter 转换为 biz.PostFilter
+func ProtoPostFilterToBiz(f *pb.PostFilter) *biz.PostFilter {
+	if f == nil {
+		return nil
+	}
+	res := &biz.PostFilter{}
+	if v := f.GetUuid(); v != nil {
+		x := &biz.ValueFilter[string]{}
+		x.EQ = v.Eq
+		x.In = v.In
+		res.UUID = x
+	}
+	if v := f.GetCreatedAt(); v != nil {
+		x := &biz.RangeFilter[time.Time]{}
+		if v.Eq != nil {
+			val := v.Eq.AsTime()
+			x.EQ = &val
+		}
+		for _, item := range v.In {
+			x.In = append(x.In, item.AsTime())
+		}
+		if v.Gt != nil {
+			val := v.Gt.AsTime()
+			x.GT = &val
+		}
+		if v.Gte != nil {
+			val := v.Gte.AsTime()
+			x.GTE = &val
+		}
+		if v.Lt != nil {
+			val := v.Lt.AsTime()
+			x.LT = &val
+		}
+		if v.Lte != nil {
+			val := v.Lte.AsTime()
+			x.LTE = &val
+		}
+		res.CreatedAt = x
+	}
+	if v := f.GetUpdatedAt(); v != nil {
+		x := &biz.RangeFilter[time.Time]{}
+		if v.Eq != nil {
+			val := v.Eq.AsTime()
+			x.EQ = &val
+		}
+		for _, item := range v.In {
+			x.In = append(x.In, item.AsTime())
+		}
+		if v.Gt != nil {
+			val := v.Gt.AsTime()
+			x.GT = &val
+		}
+		if v.Gte != nil {
+			val := v.Gte.AsTime()
+			x.GTE = &val
+		}
+		if v.Lt != nil {
+			val := v.Lt.AsTime()
+			x.LT = &val
+		}
+		if v.Lte != nil {
+			val := v.Lte.AsTime()
+			x.LTE = &val
+		}
+		res.UpdatedAt = x
+	}
+	if v := f.GetTitle(); v != nil {
+		x := &biz.StringFilter{}
+		x.EQ = v.Eq
+		x.In = v.In
+		x.Contains = v.Contains
+		res.Title = x
+	}
+	if v := f.GetContent(); v != nil {
+		x := &biz.StringFilter{}
+		x.EQ = v.Eq
+		x.In = v.In
+		x.Contains = v.Contains
+		res.Content = x
+	}
+	return res
+}
+
+// ProtoPostOrderByToBiz 将 PostOrderBy 转换为 biz.PostOrderField
+func ProtoPostOrderByToBiz(o pb.PostOrderBy) (biz.PostOrderField, error) {
+	switch o {
+	case pb.PostOrderBy_POSTORDERBY_UNSPECIFIED:
+		return biz.PostOrderByID, nil
+	case pb.PostOrderBy_POSTORDERBY_CREATED_AT:
+		return biz.PostOrderByCreatedAt, nil
+	case pb.PostOrderBy_POSTORDERBY_UPDATED_AT:
+		return biz.PostOrderByUpdatedAt, nil
+	case pb.PostOrderBy_POSTORDERBY_TITLE:
+		return biz.PostOrderByTitle, nil
+	case pb.PostOrderBy_POSTORDERBY_CONTENT:
+		return biz.PostOrderByContent, nil
+	default:
+		return "", invalidArgument("Post", fmt.Errorf("unknown order_by: %v", o))
+	}
+}
+
+// FormatUserResourceName 返回 User 的资源名称 users/{user}
+func FormatUserResourceName(userID string) string {
+	return "users/" + userID
+}
+
+// ParseUserResourceName 解析 User 的资源名称 users/{user}
+func ParseUserResourceName(name string) (userID string, err error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 2 || parts[0] != "users" || parts[1] == "" {
+		return "", invalidArgument("User", fmt.Errorf("invalid User resource name %q, want users/{user}", name))
+	}
+	return parts[1], nil
+}
+
+// bizUserResourceName 返回 b 的资源名称，b 未携带的父资源 ID 使用通配符 "-"
+func bizUserResourceName(b *biz.User) string {
+	return FormatUserResourceName(b.UUID)
+}
+
 func BizUserToProto(b *biz.User) (*pb.User, error) {
 	if b == nil {
 		return nil, errors.New("BizUserToProto: nil entity")
@@ -94,16 +527,16 @@ func BizUserToProto(b *biz.User) (*pb.User, error) {
 	for _, item := range b.PostIDs {
 		postIds = append(postIds, item)
 	}
-	var groups []*pb.Group
+
+	var groupIds []string
 	for _, item := range b.Groups {
-		v, err := BizGroupToProto(item)
-		if err != nil {
-			return nil, err
+		if item == nil {
+			continue
 		}
-		groups = append(groups, v)
+		groupIds = append(groupIds, item.UUID)
 	}
 	return &pb.User{
-		Uuid:             b.UUID,
+		ResourceName:     bizUserResourceName(b),
 		CreatedAt:        timestamppb.New(b.CreatedAt),
 		UpdatedAt:        timestamppb.New(b.UpdatedAt),
 		Name:             b.Name,
@@ -116,33 +549,66 @@ func BizUserToProto(b *biz.User) (*pb.User, error) {
 		TestNillableUuid: b.TestNillableUUID,
 		Status:           BizUserStatusToProto(b.Status),
 		Role:             string(b.Role),
+		Version:          int32(b.Version),
 		PostIds:          postIds,
-		Groups:           groups,
+		GroupIds:         groupIds,
+		PostCount:        int64(b.PostCount),
+		GroupCount:       int64(b.GroupCount),
+		FriendCount:      int64(b.FriendCount),
 	}, nil
 }
 
+// redactUserProto 清除 p 中角色 role 不可见的字段
+func redactUserProto(role string, p *pb.User) {
+	if p == nil {
+		return
+	}
+	var zero pb.User
+	if !slices.Contains([]string{"manager", "leader"}, role) {
+		p.UserScore = zero.UserScore
+	}
+	if !slices.Contains([]string{"manager"}, role) {
+		p.GroupIds = zero.GroupIds
+		p.GroupCount = zero.GroupCount
+	}
+}
+
+// BizUserToProtoFor 同 BizUserToProto，并清除角色 role 不可见的字段
+func BizUserToProtoFor(role string, b *biz.User) (*pb.User, error) {
+	p, err := BizUserToProto(b)
+	if err != nil {
+		return nil, err
+	}
+	redactUserProto(role, p)
+	return p, nil
+}
+
 func ProtoUserToBiz(p *pb.User) (*biz.User, error) {
 	if p == nil {
 		return nil, errors.New("ProtoUserToBiz: nil entity")
 	}
+	var resourceID string
+	if p.ResourceName != "" {
+		var err error
+		if resourceID, err = ParseUserResourceName(p.ResourceName); err != nil {
+			return nil, err
+		}
+	}
 
 	var postIds []string
 	for _, item := range p.PostIds {
 		postIds = append(postIds, item)
 	}
 	var groups []*biz.Group
-	for _, item := range p.Groups {
-		v, err := ProtoGroupToBiz(item)
-		if err != nil {
-			return nil, err
+	for _, item := range p.GroupIds {
+		if _, err := uuid.Parse(item); err != nil {
+			return nil, invalidArgument("User", fmt.Errorf("invalid UUID for groups: %w", err))
 		}
-		groups = append(groups, v)
+		groups = append(groups, biz.NewGroupStub(item))
 	}
 	return &biz.User{
 		UserBase: biz.UserBase{
-			UUID:             p.Uuid,
-			CreatedAt:        p.CreatedAt.AsTime(),
-			UpdatedAt:        p.UpdatedAt.AsTime(),
+			UUID:             resourceID,
 			Name:             p.Name,
 			Age:              int(p.Age),
 			Nickname:         p.Nickname,
@@ -153,12 +619,418 @@ func ProtoUserToBiz(p *pb.User) (*biz.User, error) {
 			TestNillableUUID: p.TestNillableUuid,
 			Status:           ProtoUserStatusToBiz(p.Status),
 			Role:             auth.UserRole(p.Role),
+			Version:          int(p.Version),
 			PostIDs:          postIds,
 			Groups:           groups,
+			PostCount:        int(p.PostCount),
+			GroupCount:       int(p.GroupCount),
+			FriendCount:      int(p.FriendCount),
 		},
 	}, nil
 }
 
+func ProtoCreateUserRequestToBiz(p *pb.CreateUserRequest) (*biz.User, error) {
+	if p == nil {
+		return nil, errors.New("ProtoCreateUserRequestToBiz: nil request")
+	}
+	b := &biz.User{}
+	b.Name = p.Name
+	b.Age = int(p.Age)
+	if p.Nickname != nil {
+		b.Nickname = *p.Nickname
+	}
+	if p.UserScore != nil {
+		b.UserScore = uint8(*p.UserScore)
+	}
+	if p.IsVerified != nil {
+		b.IsVerified = *p.IsVerified
+	}
+	b.Tags = p.Tags
+	if p.TestUuid != nil {
+		b.TestUUID = *p.TestUuid
+	}
+	if p.TestNillableUuid != nil {
+		b.TestNillableUUID = *p.TestNillableUuid
+	}
+	b.Status = ProtoUserStatusToBiz(p.Status)
+	if p.Role != nil {
+		b.Role = auth.UserRole(*p.Role)
+	}
+	b.PostIDs = p.PostIds
+	return b, nil
+}
+
+func ProtoUpdateUserRequestToBiz(p *pb.UpdateUserRequest) (*biz.UserPatch, error) {
+	if p == nil {
+		return nil, errors.New("ProtoUpdateUserRequestToBiz: nil request")
+	}
+	id, err := ParseUserResourceName(p.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+	patch := &biz.UserPatch{UUID: id}
+	if p.Name != nil {
+		patch.Name = p.Name
+	}
+	if p.Age != nil {
+		ageVal := int(*p.Age)
+		patch.Age = &ageVal
+	}
+	if p.Nickname != nil {
+		patch.Nickname = p.Nickname
+	}
+	if p.UserScore != nil {
+		scoreVal := uint8(*p.UserScore)
+		patch.UserScore = &scoreVal
+	}
+	if p.IsVerified != nil {
+		patch.IsVerified = p.IsVerified
+	}
+	if len(p.Tags) > 0 {
+		patch.Tags = p.Tags
+	}
+	if p.TestUuid != nil {
+		patch.TestUUID = p.TestUuid
+	}
+	if p.TestNillableUuid != nil {
+		patch.TestNillableUUID = p.TestNillableUuid
+	}
+	if p.Status != nil {
+		statusVal := ProtoUserStatusToBiz(*p.Status)
+		patch.Status = &statusVal
+	}
+	if p.Role != nil {
+		roleVal := auth.UserRole(*p.Role)
+		patch.Role = &roleVal
+	}
+	if p.Version == nil {
+		return nil, invalidArgument("User", errors.New("ProtoUpdateUserRequestToBiz: version is required"))
+	}
+	if p.Version != nil {
+		versionVal := int(*p.Version)
+		patch.Version = &versionVal
+	}
+	return patch, nil
+}
+
+// ProtoUpdateUserRequestMask 返回 UpdateUserRequest 的更新路径：优先使用 update_mask，否则为请求中出现的字段
+func ProtoUpdateUserRequestMask(p *pb.UpdateUserRequest) []string {
+	if paths := p.GetUpdateMask().GetPaths(); len(paths) > 0 {
+		return paths
+	}
+	var paths []string
+	if p.Name != nil {
+		paths = append(paths, "name")
+	}
+	if p.Age != nil {
+		paths = append(paths, "age")
+	}
+	if p.Nickname != nil {
+		paths = append(paths, "nickname")
+	}
+	if p.UserScore != nil {
+		paths = append(paths, "user_score")
+	}
+	if p.IsVerified != nil {
+		paths = append(paths, "is_verified")
+	}
+	if len(p.Tags) > 0 {
+		paths = append(paths, "tags")
+	}
+	if p.TestUuid != nil {
+		paths = append(paths, "test_uuid")
+	}
+	if p.TestNillableUuid != nil {
+		paths = append(paths, "test_nillable_uuid")
+	}
+	if p.Status != nil {
+		paths = append(paths, "status")
+	}
+	if p.Role != nil {
+		paths = append(paths, "role")
+	}
+	return paths
+}
+
+// ProtoUserFilterToBiz 将 UserFilter 转换为 biz.UserFilter
+func ProtoUserFilterToBiz(f *pb.UserFilter) *biz.UserFilter {
+	if f == nil {
+		return nil
+	}
+	res := &biz.UserFilter{}
+	if v := f.GetUuid(); v != nil {
+		x := &biz.ValueFilter[string]{}
+		x.EQ = v.Eq
+		x.In = v.In
+		res.UUID = x
+	}
+	if v := f.GetCreatedAt(); v != nil {
+		x := &biz.RangeFilter[time.Time]{}
+		if v.Eq != nil {
+			val := v.Eq.AsTime()
+			x.EQ = &val
+		}
+		for _, item := range v.In {
+			x.In = append(x.In, item.AsTime())
+		}
+		if v.Gt != nil {
+			val := v.Gt.AsTime()
+			x.GT = &val
+		}
+		if v.Gte != nil {
+			val := v.Gte.AsTime()
+			x.GTE = &val
+		}
+		if v.Lt != nil {
+			val := v.Lt.AsTime()
+			x.LT = &val
+		}
+		if v.Lte != nil {
+			val := v.Lte.AsTime()
+			x.LTE = &val
+		}
+		res.CreatedAt = x
+	}
+	if v := f.GetUpdatedAt(); v != nil {
+		x := &biz.RangeFilter[time.Time]{}
+		if v.Eq != nil {
+			val := v.Eq.AsTime()
+			x.EQ = &val
+		}
+		for _, item := range v.In {
+			x.In = append(x.In, item.AsTime())
+		}
+		if v.Gt != nil {
+			val := v.Gt.AsTime()
+			x.GT = &val
+		}
+		if v.Gte != nil {
+			val := v.Gte.AsTime()
+			x.GTE = &val
+		}
+		if v.Lt != nil {
+			val := v.Lt.AsTime()
+			x.LT = &val
+		}
+		if v.Lte != nil {
+			val := v.Lte.AsTime()
+			x.LTE = &val
+		}
+		res.UpdatedAt = x
+	}
+	if v := f.GetName(); v != nil {
+		x := &biz.StringFilter{}
+		x.EQ = v.Eq
+		x.In = v.In
+		x.Contains = v.Contains
+		res.Name = x
+	}
+	if v := f.GetAge(); v != nil {
+		x := &biz.RangeFilter[int]{}
+		if v.Eq != nil {
+			val := int(*v.Eq)
+			x.EQ = &val
+		}
+		for _, item := range v.In {
+			x.In = append(x.In, int(item))
+		}
+		if v.Gt != nil {
+			val := int(*v.Gt)
+			x.GT = &val
+		}
+		if v.Gte != nil {
+			val := int(*v.Gte)
+			x.GTE = &val
+		}
+		if v.Lt != nil {
+			val := int(*v.Lt)
+			x.LT = &val
+		}
+		if v.Lte != nil {
+			val := int(*v.Lte)
+			x.LTE = &val
+		}
+		res.Age = x
+	}
+	if v := f.GetNickname(); v != nil {
+		x := &biz.StringFilter{}
+		x.EQ = v.Eq
+		x.In = v.In
+		x.Contains = v.Contains
+		res.Nickname = x
+	}
+	if v := f.GetUserScore(); v != nil {
+		x := &biz.RangeFilter[uint8]{}
+		if v.Eq != nil {
+			val := uint8(*v.Eq)
+			x.EQ = &val
+		}
+		for _, item := range v.In {
+			x.In = append(x.In, uint8(item))
+		}
+		if v.Gt != nil {
+			val := uint8(*v.Gt)
+			x.GT = &val
+		}
+		if v.Gte != nil {
+			val := uint8(*v.Gte)
+			x.GTE = &val
+		}
+		if v.Lt != nil {
+			val := uint8(*v.Lt)
+			x.LT = &val
+		}
+		if v.Lte != nil {
+			val := uint8(*v.Lte)
+			x.LTE = &val
+		}
+		res.UserScore = x
+	}
+	if v := f.GetIsVerified(); v != nil {
+		x := &biz.BoolFilter{}
+		x.EQ = v.Eq
+		res.IsVerified = x
+	}
+	if v := f.GetTestUuid(); v != nil {
+		x := &biz.ValueFilter[string]{}
+		x.EQ = v.Eq
+		x.In = v.In
+		res.TestUUID = x
+	}
+	if v := f.GetTestNillableUuid(); v != nil {
+		x := &biz.ValueFilter[string]{}
+		x.EQ = v.Eq
+		x.In = v.In
+		res.TestNillableUUID = x
+	}
+	if v := f.GetStatus(); v != nil {
+		x := &biz.ValueFilter[biz.UserStatus]{}
+		if v.Eq != nil {
+			val := ProtoUserStatusToBiz(*v.Eq)
+			x.EQ = &val
+		}
+		for _, item := range v.In {
+			x.In = append(x.In, ProtoUserStatusToBiz(item))
+		}
+		res.Status = x
+	}
+	if v := f.GetRole(); v != nil {
+		x := &biz.ValueFilter[auth.UserRole]{}
+		if v.Eq != nil {
+			val := auth.UserRole(*v.Eq)
+			x.EQ = &val
+		}
+		for _, item := range v.In {
+			x.In = append(x.In, auth.UserRole(item))
+		}
+		res.Role = x
+	}
+	if v := f.GetVersion(); v != nil {
+		x := &biz.RangeFilter[int]{}
+		if v.Eq != nil {
+			val := int(*v.Eq)
+			x.EQ = &val
+		}
+		for _, item := range v.In {
+			x.In = append(x.In, int(item))
+		}
+		if v.Gt != nil {
+			val := int(*v.Gt)
+			x.GT = &val
+		}
+		if v.Gte != nil {
+			val := int(*v.Gte)
+			x.GTE = &val
+		}
+		if v.Lt != nil {
+			val := int(*v.Lt)
+			x.LT = &val
+		}
+		if v.Lte != nil {
+			val := int(*v.Lte)
+			x.LTE = &val
+		}
+		res.Version = x
+	}
+	return res
+}
+
+// ProtoUserOrderByToBiz 将 UserOrderBy 转换为 biz.UserOrderField
+func ProtoUserOrderByToBiz(o pb.UserOrderBy) (biz.UserOrderField, error) {
+	switch o {
+	case pb.UserOrderBy_USERORDERBY_UNSPECIFIED:
+		return biz.UserOrderByID, nil
+	case pb.UserOrderBy_USERORDERBY_CREATED_AT:
+		return biz.UserOrderByCreatedAt, nil
+	case pb.UserOrderBy_USERORDERBY_UPDATED_AT:
+		return biz.UserOrderByUpdatedAt, nil
+	case pb.UserOrderBy_USERORDERBY_NAME:
+		return biz.UserOrderByName, nil
+	case pb.UserOrderBy_USERORDERBY_AGE:
+		return biz.UserOrderByAge, nil
+	case pb.UserOrderBy_USERORDERBY_VERSION:
+		return biz.UserOrderByVersion, nil
+	default:
+		return "", invalidArgument("User", fmt.Errorf("unknown order_by: %v", o))
+	}
+}
+
+// ProtoListUsersRequestToBiz 将 ListUsersRequest 转换为 biz.UserListOptions
+func ProtoListUsersRequestToBiz(p *pb.ListUsersRequest) (*biz.UserListOptions, error) {
+	orderBy, err := ProtoUserOrderByToBiz(p.GetOrderBy())
+	if err != nil {
+		return nil, err
+	}
+	return &biz.UserListOptions{
+		PageSize:       int(p.GetPageSize()),
+		PageToken:      p.GetPageToken(),
+		Filter:         ProtoUserFilterToBiz(p.GetFilter()),
+		OrderBy:        orderBy,
+		Desc:           p.GetDesc(),
+		IncludeDeleted: p.GetIncludeDeleted(),
+	}, nil
+}
+
+// ProtoListUserPostsRequestToBiz 将 ListUserPostsRequest 转换为 biz.PostListOptions
+func ProtoListUserPostsRequestToBiz(p *pb.ListUserPostsRequest) (*biz.PostListOptions, error) {
+	orderBy, err := ProtoPostOrderByToBiz(p.GetOrderBy())
+	if err != nil {
+		return nil, err
+	}
+	return &biz.PostListOptions{
+		PageSize:  int(p.GetPageSize()),
+		PageToken: p.GetPageToken(),
+		Filter:    ProtoPostFilterToBiz(p.GetFilter()),
+		OrderBy:   orderBy,
+		Desc:      p.GetDesc(),
+	}, nil
+}
+
+func BizMembershipRoleToProto(e biz.MembershipRole) pb.MembershipRole {
+	switch e {
+	case biz.MembershipRoleUnspecified:
+		return pb.MembershipRole_MEMBERSHIPROLE_UNSPECIFIED
+	case biz.MembershipRoleMember:
+		return pb.MembershipRole_MEMBERSHIPROLE_MEMBER
+	case biz.MembershipRoleOwner:
+		return pb.MembershipRole_MEMBERSHIPROLE_OWNER
+	default:
+		return pb.MembershipRole_MEMBERSHIPROLE_UNSPECIFIED
+	}
+}
+
+func ProtoMembershipRoleToBiz(e pb.MembershipRole) biz.MembershipRole {
+	switch e {
+	case pb.MembershipRole_MEMBERSHIPROLE_UNSPECIFIED:
+		return biz.MembershipRoleUnspecified
+	case pb.MembershipRole_MEMBERSHIPROLE_MEMBER:
+		return biz.MembershipRoleMember
+	case pb.MembershipRole_MEMBERSHIPROLE_OWNER:
+		return biz.MembershipRoleOwner
+	default:
+		return biz.MembershipRoleUnspecified
+	}
+}
 func BizUserStatusToProto(e biz.UserStatus) pb.UserStatus {
 	switch e {
 	case biz.UserStatusUnspecified:
@@ -188,3 +1060,41 @@ func ProtoUserStatusToBiz(e pb.UserStatus) biz.UserStatus {
 		return biz.UserStatusUnspecified
 	}
 }
+
+func BizGroupMembershipToProto(b *biz.GroupMembership) (*pb.GroupMembership, error) {
+	if b == nil {
+		return nil, errors.New("BizGroupMembershipToProto: nil entity")
+	}
+	p := &pb.GroupMembership{
+		CreatedAt: timestamppb.New(b.CreatedAt),
+		UpdatedAt: timestamppb.New(b.UpdatedAt),
+		Role:      BizMembershipRoleToProto(b.Role),
+		JoinedAt:  timestamppb.New(b.JoinedAt),
+	}
+	if b.User != nil {
+		v, err := BizUserToProto(b.User)
+		if err != nil {
+			return nil, err
+		}
+		p.User = v
+	}
+	return p, nil
+}
+
+func ProtoGroupMembershipToBiz(p *pb.GroupMembership) (*biz.GroupMembership, error) {
+	if p == nil {
+		return nil, errors.New("ProtoGroupMembershipToBiz: nil entity")
+	}
+	b := &biz.GroupMembership{
+		Role:     ProtoMembershipRoleToBiz(p.Role),
+		JoinedAt: p.JoinedAt.AsTime(),
+	}
+	if p.User != nil {
+		v, err := ProtoUserToBiz(p.User)
+		if err != nil {
+			return nil, err
+		}
+		b.User = v
+	}
+	return b, nil
+}
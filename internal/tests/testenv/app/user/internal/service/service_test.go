@@ -0,0 +1,56 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/biz"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/data/ent"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/service"
+)
+
+// newClient opens an in-memory sqlite database private to the test, with the
+// schema migrated.
+func newClient(t *testing.T) *ent.Client {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", strings.ReplaceAll(t.Name(), "/", "_"))
+	c, err := ent.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	if err := c.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+	return c
+}
+
+// The services are served for a single tenant.
+func tenant(context.Context) (string, error) { return "t1", nil }
+
+type roleKey struct{}
+
+func withRole(ctx context.Context, role string) context.Context {
+	return context.WithValue(ctx, roleKey{}, role)
+}
+
+// roleFromContext is the service.RoleResolver injected into the services.
+func roleFromContext(ctx context.Context) string {
+	role, _ := ctx.Value(roleKey{}).(string)
+	return role
+}
+
+func newUserService(c *ent.Client, role service.RoleResolver) *service.UserService {
+	uc := biz.NewUserUsecase(data.NewUserRepo(c, tenant), data.NewTransaction(c))
+	return service.NewUserService(uc, role)
+}
+
+func newGroupService(c *ent.Client, role service.RoleResolver) *service.GroupService {
+	uc := biz.NewGroupUsecase(data.NewGroupRepo(c, tenant), data.NewTransaction(c))
+	return service.NewGroupService(uc, role)
+}
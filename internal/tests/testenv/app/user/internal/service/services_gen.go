@@ -0,0 +1,393 @@
+// Code generated by lazyent. DO NOT EDIT.
+package service
+
+import (
+	"context"
+	"iter"
+
+	pb "github.com/Cromemadnd/lazyent/internal/tests/testenv/api/v1"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/biz"
+)
+
+// GroupUsecase 声明 GroupService 依赖的 Biz 用例
+type GroupUsecase interface {
+	Create(ctx context.Context, b *biz.Group) (*biz.Group, error)
+	Get(ctx context.Context, id string) (*biz.Group, error)
+	GetByName(ctx context.Context, name string) (*biz.Group, error)
+	Update(ctx context.Context, p *biz.GroupPatch, mask []string) (*biz.Group, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, opts *biz.GroupListOptions) ([]*biz.Group, string, error)
+	ListUsers(ctx context.Context, id string, opts *biz.UserListOptions) ([]*biz.User, string, error)
+	AddUsers(ctx context.Context, id string, ids []string) error
+	RemoveUsers(ctx context.Context, id string, ids []string) error
+}
+
+// BizToCreateGroupReply 将 b 转换为 CreateGroupReply，并清除角色 role 不可见的字段
+func BizToCreateGroupReply(role string, b *biz.Group) (*pb.CreateGroupReply, error) {
+	v, err := BizGroupToProtoFor(role, b)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateGroupReply{Group: v}, nil
+}
+
+// BizToGetGroupReply 将 b 转换为 GetGroupReply，并清除角色 role 不可见的字段
+func BizToGetGroupReply(role string, b *biz.Group) (*pb.GetGroupReply, error) {
+	v, err := BizGroupToProtoFor(role, b)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetGroupReply{Group: v}, nil
+}
+
+// BizToGetGroupByNameReply 将 b 转换为 GetGroupByNameReply，并清除角色 role 不可见的字段
+func BizToGetGroupByNameReply(role string, b *biz.Group) (*pb.GetGroupByNameReply, error) {
+	v, err := BizGroupToProtoFor(role, b)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetGroupByNameReply{Group: v}, nil
+}
+
+// BizToUpdateGroupReply 将 b 转换为 UpdateGroupReply，并清除角色 role 不可见的字段
+func BizToUpdateGroupReply(role string, b *biz.Group) (*pb.UpdateGroupReply, error) {
+	v, err := BizGroupToProtoFor(role, b)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateGroupReply{Group: v}, nil
+}
+
+// BizToListGroupsReply 将 items 与下一页的游标 next 转换为 ListGroupsReply，并清除角色 role 不可见的字段
+func BizToListGroupsReply(role string, items []*biz.Group, next string) (*pb.ListGroupsReply, error) {
+	res := make([]*pb.Group, 0, len(items))
+	for _, item := range items {
+		v, err := BizGroupToProtoFor(role, item)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return &pb.ListGroupsReply{Groups: res, NextPageToken: next}, nil
+}
+
+// BizToListGroupUsersReply 将 items 与下一页的游标 next 转换为 ListGroupUsersReply，并清除角色 role 不可见的字段
+func BizToListGroupUsersReply(role string, items []*biz.User, next string) (*pb.ListGroupUsersReply, error) {
+	res := make([]*pb.User, 0, len(items))
+	for _, item := range items {
+		v, err := BizUserToProtoFor(role, item)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return &pb.ListGroupUsersReply{Users: res, NextPageToken: next}, nil
+}
+
+// GroupServiceBase 实现 pb.GroupServiceServer 的 CRUD 方法，由 GroupService 嵌入
+type GroupServiceBase struct {
+	pb.UnimplementedGroupServiceServer
+
+	Usecase GroupUsecase
+	Role    RoleResolver
+}
+
+// NewGroupServiceBase 创建 GroupServiceBase，回复中的受限字段按 role 给出的调用方角色清除
+func NewGroupServiceBase(uc GroupUsecase, role RoleResolver) *GroupServiceBase {
+	return &GroupServiceBase{Usecase: uc, Role: role}
+}
+
+func (s *GroupServiceBase) CreateGroup(ctx context.Context, req *pb.CreateGroupRequest) (*pb.CreateGroupReply, error) {
+	b, err := ProtoCreateGroupRequestToBiz(req)
+	if err != nil {
+		return nil, err
+	}
+	b, err = s.Usecase.Create(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	return BizToCreateGroupReply(callerRole(ctx, s.Role), b)
+}
+
+func (s *GroupServiceBase) GetGroup(ctx context.Context, req *pb.GetGroupRequest) (*pb.GetGroupReply, error) {
+	b, err := s.Usecase.Get(ctx, req.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+	return BizToGetGroupReply(callerRole(ctx, s.Role), b)
+}
+
+func (s *GroupServiceBase) GetGroupByName(ctx context.Context, req *pb.GetGroupByNameRequest) (*pb.GetGroupByNameReply, error) {
+	b, err := s.Usecase.GetByName(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return BizToGetGroupByNameReply(callerRole(ctx, s.Role), b)
+}
+
+func (s *GroupServiceBase) UpdateGroup(ctx context.Context, req *pb.UpdateGroupRequest) (*pb.UpdateGroupReply, error) {
+	patch, err := ProtoUpdateGroupRequestToBiz(req)
+	if err != nil {
+		return nil, err
+	}
+	b, err := s.Usecase.Update(ctx, patch, ProtoUpdateGroupRequestMask(req))
+	if err != nil {
+		return nil, err
+	}
+	return BizToUpdateGroupReply(callerRole(ctx, s.Role), b)
+}
+
+func (s *GroupServiceBase) DeleteGroup(ctx context.Context, req *pb.DeleteGroupRequest) (*pb.DeleteGroupReply, error) {
+	if err := s.Usecase.Delete(ctx, req.GetUuid()); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteGroupReply{}, nil
+}
+
+func (s *GroupServiceBase) ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb.ListGroupsReply, error) {
+	opts, err := ProtoListGroupsRequestToBiz(req)
+	if err != nil {
+		return nil, err
+	}
+	items, next, err := s.Usecase.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return BizToListGroupsReply(callerRole(ctx, s.Role), items, next)
+}
+
+func (s *GroupServiceBase) ListGroupUsers(ctx context.Context, req *pb.ListGroupUsersRequest) (*pb.ListGroupUsersReply, error) {
+	opts, err := ProtoListGroupUsersRequestToBiz(req)
+	if err != nil {
+		return nil, err
+	}
+	items, next, err := s.Usecase.ListUsers(ctx, req.GetUuid(), opts)
+	if err != nil {
+		return nil, err
+	}
+	return BizToListGroupUsersReply(callerRole(ctx, s.Role), items, next)
+}
+
+func (s *GroupServiceBase) AddGroupUsers(ctx context.Context, req *pb.AddGroupUsersRequest) (*pb.AddGroupUsersReply, error) {
+	if err := s.Usecase.AddUsers(ctx, req.GetUuid(), req.GetUserIds()); err != nil {
+		return nil, err
+	}
+	return &pb.AddGroupUsersReply{}, nil
+}
+
+func (s *GroupServiceBase) RemoveGroupUsers(ctx context.Context, req *pb.RemoveGroupUsersRequest) (*pb.RemoveGroupUsersReply, error) {
+	if err := s.Usecase.RemoveUsers(ctx, req.GetUuid(), req.GetUserIds()); err != nil {
+		return nil, err
+	}
+	return &pb.RemoveGroupUsersReply{}, nil
+}
+
+// UserUsecase 声明 UserService 依赖的 Biz 用例
+type UserUsecase interface {
+	Create(ctx context.Context, b *biz.User) (*biz.User, error)
+	Get(ctx context.Context, id string) (*biz.User, error)
+	GetByNameAndAge(ctx context.Context, name string, age int) (*biz.User, error)
+	Update(ctx context.Context, p *biz.UserPatch, mask []string) (*biz.User, error)
+	Delete(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) (*biz.User, error)
+	List(ctx context.Context, opts *biz.UserListOptions) ([]*biz.User, string, error)
+	Stream(ctx context.Context, filter *biz.UserFilter, batchSize int) iter.Seq2[*biz.User, error]
+	ListPosts(ctx context.Context, id string, opts *biz.PostListOptions) ([]*biz.Post, string, error)
+}
+
+// BizToCreateUserReply 将 b 转换为 CreateUserReply，并清除角色 role 不可见的字段
+func BizToCreateUserReply(role string, b *biz.User) (*pb.CreateUserReply, error) {
+	v, err := BizUserToProtoFor(role, b)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateUserReply{User: v}, nil
+}
+
+// BizToGetUserReply 将 b 转换为 GetUserReply，并清除角色 role 不可见的字段
+func BizToGetUserReply(role string, b *biz.User) (*pb.GetUserReply, error) {
+	v, err := BizUserToProtoFor(role, b)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetUserReply{User: v}, nil
+}
+
+// BizToGetUserByNameAndAgeReply 将 b 转换为 GetUserByNameAndAgeReply，并清除角色 role 不可见的字段
+func BizToGetUserByNameAndAgeReply(role string, b *biz.User) (*pb.GetUserByNameAndAgeReply, error) {
+	v, err := BizUserToProtoFor(role, b)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetUserByNameAndAgeReply{User: v}, nil
+}
+
+// BizToUpdateUserReply 将 b 转换为 UpdateUserReply，并清除角色 role 不可见的字段
+func BizToUpdateUserReply(role string, b *biz.User) (*pb.UpdateUserReply, error) {
+	v, err := BizUserToProtoFor(role, b)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateUserReply{User: v}, nil
+}
+
+// BizToRestoreUserReply 将 b 转换为 RestoreUserReply，并清除角色 role 不可见的字段
+func BizToRestoreUserReply(role string, b *biz.User) (*pb.RestoreUserReply, error) {
+	v, err := BizUserToProtoFor(role, b)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreUserReply{User: v}, nil
+}
+
+// BizToListUsersReply 将 items 与下一页的游标 next 转换为 ListUsersReply，并清除角色 role 不可见的字段
+func BizToListUsersReply(role string, items []*biz.User, next string) (*pb.ListUsersReply, error) {
+	res := make([]*pb.User, 0, len(items))
+	for _, item := range items {
+		v, err := BizUserToProtoFor(role, item)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return &pb.ListUsersReply{Users: res, NextPageToken: next}, nil
+}
+
+// BizToListUserPostsReply 将 items 与下一页的游标 next 转换为 ListUserPostsReply
+func BizToListUserPostsReply(items []*biz.Post, next string) (*pb.ListUserPostsReply, error) {
+	res := make([]*pb.Post, 0, len(items))
+	for _, item := range items {
+		v, err := BizPostToProto(item)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return &pb.ListUserPostsReply{Posts: res, NextPageToken: next}, nil
+}
+
+// UserServiceBase 实现 pb.UserServiceServer 的 CRUD 方法，由 UserService 嵌入
+type UserServiceBase struct {
+	pb.UnimplementedUserServiceServer
+
+	Usecase UserUsecase
+	Role    RoleResolver
+}
+
+// NewUserServiceBase 创建 UserServiceBase，回复中的受限字段按 role 给出的调用方角色清除
+func NewUserServiceBase(uc UserUsecase, role RoleResolver) *UserServiceBase {
+	return &UserServiceBase{Usecase: uc, Role: role}
+}
+
+func (s *UserServiceBase) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserReply, error) {
+	b, err := ProtoCreateUserRequestToBiz(req)
+	if err != nil {
+		return nil, err
+	}
+	b, err = s.Usecase.Create(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	return BizToCreateUserReply(callerRole(ctx, s.Role), b)
+}
+
+func (s *UserServiceBase) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserReply, error) {
+	id, err := ParseUserResourceName(req.GetResourceName())
+	if err != nil {
+		return nil, err
+	}
+	b, err := s.Usecase.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return BizToGetUserReply(callerRole(ctx, s.Role), b)
+}
+
+func (s *UserServiceBase) GetUserByNameAndAge(ctx context.Context, req *pb.GetUserByNameAndAgeRequest) (*pb.GetUserByNameAndAgeReply, error) {
+	b, err := s.Usecase.GetByNameAndAge(ctx, req.GetName(), int(req.GetAge()))
+	if err != nil {
+		return nil, err
+	}
+	return BizToGetUserByNameAndAgeReply(callerRole(ctx, s.Role), b)
+}
+
+func (s *UserServiceBase) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserReply, error) {
+	patch, err := ProtoUpdateUserRequestToBiz(req)
+	if err != nil {
+		return nil, err
+	}
+	b, err := s.Usecase.Update(ctx, patch, ProtoUpdateUserRequestMask(req))
+	if err != nil {
+		return nil, err
+	}
+	return BizToUpdateUserReply(callerRole(ctx, s.Role), b)
+}
+
+func (s *UserServiceBase) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserReply, error) {
+	id, err := ParseUserResourceName(req.GetResourceName())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Usecase.Delete(ctx, id); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteUserReply{}, nil
+}
+
+func (s *UserServiceBase) RestoreUser(ctx context.Context, req *pb.RestoreUserRequest) (*pb.RestoreUserReply, error) {
+	id, err := ParseUserResourceName(req.GetResourceName())
+	if err != nil {
+		return nil, err
+	}
+	b, err := s.Usecase.Restore(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return BizToRestoreUserReply(callerRole(ctx, s.Role), b)
+}
+
+func (s *UserServiceBase) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersReply, error) {
+	opts, err := ProtoListUsersRequestToBiz(req)
+	if err != nil {
+		return nil, err
+	}
+	items, next, err := s.Usecase.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return BizToListUsersReply(callerRole(ctx, s.Role), items, next)
+}
+
+func (s *UserServiceBase) StreamUsers(req *pb.StreamUsersRequest, stream pb.UserService_StreamUsersServer) error {
+	ctx := stream.Context()
+	role := callerRole(ctx, s.Role)
+	for b, err := range s.Usecase.Stream(ctx, ProtoUserFilterToBiz(req.GetFilter()), int(req.GetBatchSize())) {
+		if err != nil {
+			return err
+		}
+		v, err := BizUserToProtoFor(role, b)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *UserServiceBase) ListUserPosts(ctx context.Context, req *pb.ListUserPostsRequest) (*pb.ListUserPostsReply, error) {
+	id, err := ParseUserResourceName(req.GetResourceName())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := ProtoListUserPostsRequestToBiz(req)
+	if err != nil {
+		return nil, err
+	}
+	items, next, err := s.Usecase.ListPosts(ctx, id, opts)
+	if err != nil {
+		return nil, err
+	}
+	return BizToListUserPostsReply(items, next)
+}
@@ -0,0 +1,12 @@
+// 该文件仅生成一次，可以在此添加自定义业务逻辑。
+package service
+
+// UserService 实现 pb.UserServiceServer，嵌入了生成的 UserServiceBase，可以在此覆盖其方法或添加新方法。
+type UserService struct {
+	*UserServiceBase
+}
+
+// NewUserService 创建 UserService
+func NewUserService(uc UserUsecase, role RoleResolver) *UserService {
+	return &UserService{UserServiceBase: NewUserServiceBase(uc, role)}
+}
@@ -0,0 +1,47 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/Cromemadnd/lazyent/internal/tests/testenv/api/v1"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/biz"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/service"
+)
+
+// UpdateUser requires the expected version, advances it on success and
+// rejects a stale version without writing.
+func TestUpdateVersion(t *testing.T) {
+	c := newClient(t)
+	ctx := context.Background()
+	users := newUserService(c, roleFromContext)
+	u := c.User.Create().SetName("alice").SetAge(20).SetStatus("ACTIVE").SaveX(ctx)
+	name := service.FormatUserResourceName(u.ID.String())
+
+	_, err := users.UpdateUser(ctx, &pb.UpdateUserRequest{ResourceName: name, Name: proto.String("bob")})
+	if kerrors.Code(err) != http.StatusBadRequest || kerrors.Reason(err) != pb.ErrorReason_USER_INVALID_ARGUMENT.String() {
+		t.Errorf("missing version: got %v, want bad request", err)
+	}
+
+	got, err := users.UpdateUser(ctx, &pb.UpdateUserRequest{ResourceName: name, Name: proto.String("bob"), Version: proto.Int32(0)})
+	if err != nil {
+		t.Fatalf("UpdateUser: %v", err)
+	}
+	if got.GetUser().GetName() != "bob" || got.GetUser().GetVersion() != 1 {
+		t.Errorf("got name %q, version %d, want bob, 1", got.GetUser().GetName(), got.GetUser().GetVersion())
+	}
+
+	_, err = users.UpdateUser(ctx, &pb.UpdateUserRequest{ResourceName: name, Name: proto.String("carol"), Version: proto.Int32(0)})
+	if kerrors.Code(err) != http.StatusConflict || kerrors.Reason(err) != pb.ErrorReason_USER_CONCURRENT_MODIFICATION.String() ||
+		!errors.Is(err, biz.ErrConcurrentModification) {
+		t.Errorf("stale version: got %v, want concurrent modification", err)
+	}
+	if u := c.User.GetX(ctx, u.ID); u.Name != "bob" || u.Version != 1 {
+		t.Errorf("stale update wrote name %q, version %d", u.Name, u.Version)
+	}
+}
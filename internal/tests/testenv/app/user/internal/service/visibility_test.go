@@ -0,0 +1,94 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/Cromemadnd/lazyent/internal/tests/testenv/api/v1"
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/service"
+)
+
+// userStream collects the users sent by StreamUsers.
+type userStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	users []*pb.User
+}
+
+func (s *userStream) Context() context.Context { return s.ctx }
+
+func (s *userStream) Send(u *pb.User) error {
+	s.users = append(s.users, u)
+	return nil
+}
+
+// Replies clear the fields the caller role may not see, also in users nested
+// in group memberships: user_score is visible to managers and leaders, the
+// groups edge and its count to managers.
+func TestReplyVisibility(t *testing.T) {
+	c := newClient(t)
+	ctx := context.Background()
+	u := c.User.Create().SetName("alice").SetAge(20).SetStatus("ACTIVE").SetScore(90).SaveX(ctx)
+	g := c.Group.Create().SetTenantID("t1").SetName("staff").SaveX(ctx)
+	c.Membership.Create().SetGroupID(g.ID).SetUserID(u.ID).ExecX(ctx)
+	name := service.FormatUserResourceName(u.ID.String())
+
+	tests := []struct {
+		role       string
+		resolve    service.RoleResolver
+		score      uint32
+		groupCount int64
+	}{
+		{role: "manager", resolve: roleFromContext, score: 90, groupCount: 1},
+		{role: "leader", resolve: roleFromContext, score: 90},
+		{role: "user", resolve: roleFromContext},
+		{role: "", resolve: roleFromContext},
+		{role: "manager", resolve: nil},
+	}
+	for _, tt := range tests {
+		users := newUserService(c, tt.resolve)
+		groups := newGroupService(c, tt.resolve)
+		ctx := withRole(ctx, tt.role)
+		check := func(rpc string, got *pb.User) {
+			t.Helper()
+			if got.GetUserScore() != tt.score || got.GetGroupCount() != tt.groupCount || int64(len(got.GetGroupIds())) != tt.groupCount {
+				t.Errorf("%s as %q (resolver %t): got score %d, groups %v (count %d), want score %d, count %d",
+					rpc, tt.role, tt.resolve != nil, got.GetUserScore(), got.GetGroupIds(), got.GetGroupCount(), tt.score, tt.groupCount)
+			}
+		}
+
+		got, err := users.GetUser(ctx, &pb.GetUserRequest{ResourceName: name})
+		if err != nil {
+			t.Fatalf("GetUser: %v", err)
+		}
+		check("GetUser", got.GetUser())
+
+		list, err := users.ListUsers(ctx, &pb.ListUsersRequest{})
+		if err != nil || len(list.GetUsers()) != 1 {
+			t.Fatalf("ListUsers: got %v, %v, want one user", list, err)
+		}
+		check("ListUsers", list.GetUsers()[0])
+
+		stream := &userStream{ctx: ctx}
+		if err := users.StreamUsers(&pb.StreamUsersRequest{}, stream); err != nil || len(stream.users) != 1 {
+			t.Fatalf("StreamUsers: got %v, %v, want one user", stream.users, err)
+		}
+		check("StreamUsers", stream.users[0])
+
+		members, err := groups.ListGroupUsers(ctx, &pb.ListGroupUsersRequest{Uuid: g.ID.String()})
+		if err != nil || len(members.GetUsers()) != 1 {
+			t.Fatalf("ListGroupUsers: got %v, %v, want one user", members, err)
+		}
+		check("ListGroupUsers", members.GetUsers()[0])
+
+		group, err := groups.GetGroup(ctx, &pb.GetGroupRequest{Uuid: g.ID.String()})
+		if err != nil || len(group.GetGroup().GetMemberships()) != 1 || group.GetGroup().GetMemberships()[0].GetUser() == nil {
+			t.Fatalf("GetGroup: got %v, %v, want one membership with its user", group, err)
+		}
+		if got := group.GetGroup().GetMemberships()[0].GetUser().GetUserScore(); got != tt.score {
+			t.Errorf("GetGroup as %q (resolver %t): got member score %d, want %d", tt.role, tt.resolve != nil, got, tt.score)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Code generated by lazyent. DO NOT EDIT.
+package service
+
+import (
+	"github.com/google/wire"
+
+	"github.com/Cromemadnd/lazyent/internal/tests/testenv/app/user/internal/biz"
+)
+
+// ProviderSet 是 service 层生成代码的 Wire 提供者集合，新增 Schema 时自动更新
+var ProviderSet = wire.NewSet(
+	NewGroupService,
+	ProvideGroupUsecase,
+	NewUserService,
+	ProvideUserUsecase,
+)
+
+// ProvideGroupUsecase 以生成的 biz.GroupUsecase 提供 GroupService 依赖的 GroupUsecase
+func ProvideGroupUsecase(uc *biz.GroupUsecase) GroupUsecase {
+	return uc
+}
+
+// ProvideUserUsecase 以生成的 biz.UserUsecase 提供 UserService 依赖的 UserUsecase
+func ProvideUserUsecase(uc *biz.UserUsecase) UserUsecase {
+	return uc
+}
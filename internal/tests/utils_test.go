@@ -17,4 +17,41 @@ func TestNewExtension(t *testing.T) {
 	}
 }
 
-// Note: Internal logic unit tests are located in internal/gen/utils_test.go
+// The numeric values of the edge strategies are part of the public API, e.g.
+// persisted in serialized annotations.
+func TestEdgeStrategyValues(t *testing.T) {
+	want := []lazyent.EdgeFieldStrategy{
+		lazyent.BizPointerWithProtoMessage,
+		lazyent.BizPointerWithProtoID,
+		lazyent.BizPointerWithProtoExclude,
+		lazyent.BizIDWithProtoID,
+		lazyent.BizIDWithProtoExclude,
+		lazyent.BizExcludeWithProtoExclude,
+	}
+	for i, s := range want {
+		if int(s) != i {
+			t.Errorf("strategy %d has value %d", i, s)
+		}
+	}
+	through := []lazyent.EdgeThroughStrategy{lazyent.ThroughPlainList, lazyent.ThroughAssociationList, lazyent.ThroughBoth}
+	for i, s := range through {
+		if int(s) != i {
+			t.Errorf("through strategy %d has value %d", i, s)
+		}
+	}
+}
+
+// An explicit zero strategy overrides defaults, other options leave it unset.
+func TestEdgeStrategySet(t *testing.T) {
+	a := lazyent.MergeAnnotations(lazyent.WithEdgeFieldStrategy(lazyent.BizIDWithProtoID), lazyent.WithBizName("GroupIDs"))
+	if a.EdgeFieldStrategy != lazyent.BizIDWithProtoID {
+		t.Errorf("strategy overridden by an unrelated option: %v", a.EdgeFieldStrategy)
+	}
+	if lazyent.WithBizName("GroupIDs").HasEdgeFieldStrategy() {
+		t.Error("unrelated option reports a strategy")
+	}
+	a = lazyent.MergeAnnotations(lazyent.WithEdgeFieldStrategy(lazyent.BizIDWithProtoID), lazyent.WithEdgeFieldStrategy(lazyent.BizPointerWithProtoMessage))
+	if !a.HasEdgeFieldStrategy() || a.EdgeFieldStrategy != lazyent.BizPointerWithProtoMessage {
+		t.Errorf("explicit BizPointerWithProtoMessage not kept: %+v", a)
+	}
+}
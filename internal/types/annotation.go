@@ -1,5 +1,7 @@
 package types
 
+import "entgo.io/ent/schema"
+
 type EdgeFieldStrategy int // EdgeFieldStrategy 外键字段策略
 
 const (
@@ -11,6 +13,7 @@ const (
 	// BizPointerWithProtoID
 	//  - Biz:   *Group / []*Group
 	//  - Proto: string(group_id) / repeated string(group_ids)
+	//  - Proto -> Biz 时按 ID 构造占位实体 (IsStub() 为 true)
 	BizPointerWithProtoID
 
 	// BizPointerWithProtoExclude
@@ -34,20 +37,203 @@ const (
 	BizExcludeWithProtoExclude
 )
 
+type EdgeThroughStrategy int // EdgeThroughStrategy 经由 Edge Schema (edge.Through) 的 M2M Edge 的生成策略
+
+const (
+	// ThroughPlainList (Default)
+	//  - Biz:   Users []*User
+	//  - Proto: repeated User users
+	ThroughPlainList EdgeThroughStrategy = iota
+
+	// ThroughAssociationList
+	//  - Biz:   Memberships []*GroupMembership (GroupMembership{User *User; Role ...})
+	//  - Proto: repeated GroupMembership memberships
+	ThroughAssociationList
+
+	// ThroughBoth 同时生成普通 M2M 列表与关联列表
+	ThroughBoth
+)
+
+type CRUDMethod string // CRUDMethod CRUD 服务方法
+
+const (
+	CRUDCreate      CRUDMethod = "create"        // CreateUser
+	CRUDGet         CRUDMethod = "get"           // GetUser
+	CRUDGetByUnique CRUDMethod = "get_by_unique" // 每个唯一键一个 GetXByY，例如 GetGroupByName
+	CRUDUpdate      CRUDMethod = "update"        // UpdateUser
+	CRUDDelete      CRUDMethod = "delete"        // DeleteUser
+	CRUDList        CRUDMethod = "list"          // ListUsers
+)
+
+// AllCRUDMethods 全部 CRUD 服务方法
+var AllCRUDMethods = []CRUDMethod{CRUDCreate, CRUDGet, CRUDGetByUnique, CRUDUpdate, CRUDDelete, CRUDList}
+
+type RepoMethod string // RepoMethod Biz 仓储接口方法
+
+const (
+	RepoSave         RepoMethod = "save"           // Save
+	RepoUpdate       RepoMethod = "update"         // Update
+	RepoFindByID     RepoMethod = "find_by_id"     // FindByID
+	RepoDelete       RepoMethod = "delete"         // Delete
+	RepoListAll      RepoMethod = "list_all"       // ListAll
+	RepoList         RepoMethod = "list"           // List，支持分页、过滤与排序
+	RepoFindByUnique RepoMethod = "find_by_unique" // 每个唯一键 (Unique 字段或唯一索引) 一个 FindByX，例如 FindByName
+	RepoListEdges    RepoMethod = "list_edges"     // 每个非 Unique Edge 一个 ListXY，例如 ListUserPosts
+)
+
+// AllRepoMethods 全部仓储接口方法
+var AllRepoMethods = []RepoMethod{RepoSave, RepoUpdate, RepoFindByID, RepoDelete, RepoListAll, RepoList, RepoFindByUnique, RepoListEdges}
+
+type EdgeMethod string // EdgeMethod Edge 子资源 RPC 方法
+
+const (
+	EdgeList   EdgeMethod = "list"   // 分页列出关联对象，例如 ListUserPosts
+	EdgeAdd    EdgeMethod = "add"    // 添加关联，例如 AddGroupUsers (仅 M2M Edge 有效)
+	EdgeRemove EdgeMethod = "remove" // 移除关联，例如 RemoveGroupUsers (仅 M2M Edge 有效)
+)
+
+// AllEdgeMethods 全部 Edge 子资源 RPC 方法
+var AllEdgeMethods = []EdgeMethod{EdgeList, EdgeAdd, EdgeRemove}
+
 // Annotation 定义 LazyEnt 的配置注解
+// 可用于 Field、Edge，也可用于 Schema 的 Annotations() 或 entc.Annotations 作为默认值，
+// 优先级为 Edge/Field > Schema > 全局
 type Annotation struct {
-	EnumValues        map[string]int32  `json:"enum_values"`         // EnumValues 定义枚举数值映射: "ENUM_VAL": 1 (仅 Enum Fields有效)
-	EdgeFieldStrategy EdgeFieldStrategy `json:"edge_field_strategy"` // 仅 Edge 字段有效，默认为 BizPointerWithProtoMessage
-	BizName           string            `json:"biz_name"`            // Biz Field 名称
-	BizType           string            `json:"biz_type"`            // Biz Field 自定义类型
-	ProtoName         string            `json:"proto_name"`          // Proto Field 名称
-	ProtoType         string            `json:"proto_type"`          // Proto Field 自定义类型
-	ProtoFieldID      int32             `json:"proto_field_id"`      // ProtoFieldID 指定 Proto 字段 ID
-	ProtoValidation   string            `json:"proto_validation"`    // ProtoValidation 指定 Proto 校验规则 (pgv)
-	Validation        *ValidationRules  `json:"validation"`          // Validation 指定结构化校验规则
+	EnumValues        map[string]int32    `json:"enum_values"`          // EnumValues 定义枚举数值映射: "ENUM_VAL": 1 (仅 Enum Fields有效)
+	EdgeFieldStrategy EdgeFieldStrategy   `json:"edge_field_strategy"`  // 仅 Edge 及 Schema/全局默认值有效，默认为 BizPointerWithProtoMessage
+	EdgeStrategySet   bool                `json:"edge_strategy_set"`    // 显式设置了 EdgeFieldStrategy (由 WithEdgeFieldStrategy 设置，零值策略据此覆盖 Schema/全局默认值)
+	ThroughStrategy   EdgeThroughStrategy `json:"through_strategy"`     // 仅 edge.Through 的 M2M Edge 及 Schema/全局默认值有效，默认为 ThroughPlainList
+	ThroughSet        bool                `json:"through_set"`          // 显式设置了 ThroughStrategy (由 WithThroughStrategy 设置)
+	EdgeCount         bool                `json:"edge_count"`           // 仅非 Unique Edge 有效，生成关联数量字段 (例如 PostCount)
+	BizExclude        bool                `json:"biz_exclude"`          // 仅 Field 有效，不生成 Biz 字段 (同时不生成 Proto 字段)
+	ProtoExclude      bool                `json:"proto_exclude"`        // 仅 Field 有效，不生成 Proto 字段 (Biz 字段保留)
+	ProtoReadOnly     bool                `json:"proto_read_only"`      // 仅 Field 有效，Proto 字段只读 (Proto -> Biz 时忽略)
+	VersionField      bool                `json:"version_field"`        // 仅整数 Field 有效，作为乐观锁版本号
+	VersionOptional   bool                `json:"version_optional"`     // 仅版本号 Field 有效，UpdateXRequest 可以不携带版本 (后写者胜)
+	SoftDelete        bool                `json:"soft_delete"`          // 仅 Optional time Field 有效，作为软删除时间 (例如 deleted_at)
+	SoftDeleteInProto bool                `json:"soft_delete_in_proto"` // 仅软删除 Field 有效，保留其 Proto 字段 (默认不生成)
+	Tenant            bool                `json:"tenant"`               // 仅 Field 有效，作为租户字段，由仓储按 biz.TenantResolver 限定与填充
+	VisibleTo         []string            `json:"visible_to"`           // 仅 Field/Edge 有效，Biz -> Proto 的 XxxFor 映射仅对这些角色输出该字段
+	CRUDMethods       []CRUDMethod        `json:"crud_methods"`         // 仅 Schema 有效，生成 CRUD gRPC 服务的方法
+	RepoMethods       []RepoMethod        `json:"repo_methods"`         // 仅 Schema 有效，生成 Biz 仓储接口的方法
+	EdgeMethods       []EdgeMethod        `json:"edge_methods"`         // 仅非 Unique Edge 有效，生成子资源 RPC 与仓储方法
+	BizName           string              `json:"biz_name"`             // Biz Field 名称 (仅 Field/Edge 有效)
+	BizType           string              `json:"biz_type"`             // Biz Field 自定义类型
+	ProtoName         string              `json:"proto_name"`           // Proto Field 名称 (仅 Field/Edge 有效)
+	ProtoType         string              `json:"proto_type"`           // Proto Field 自定义类型
+	ProtoFieldID      int32               `json:"proto_field_id"`       // ProtoFieldID 指定 Proto 字段 ID
+	ProtoValidation   string              `json:"proto_validation"`     // ProtoValidation 指定 Proto 校验规则 (pgv)
+	Validation        *ValidationRules    `json:"validation"`           // Validation 指定结构化校验规则，用于 Schema/全局时按类型作为未指定规则的字段的默认值
+	Resource          *Resource           `json:"resource"`             // 仅 Schema 有效，声明 AIP 资源名称
+	Stream            bool                `json:"stream"`               // 仅 Schema 有效，生成按 ID 分批读取的流式导出 RPC 与仓储方法
 }
 
 // Name 实现 ent.Annotation 接口
 func (Annotation) Name() string {
 	return "LazyEnt"
 }
+
+// HasEdgeFieldStrategy 报告是否设置了 EdgeFieldStrategy: 非零值，或经 WithEdgeFieldStrategy 显式设置
+// 未设置时依次回退到 Schema 级、全局默认策略
+func (a Annotation) HasEdgeFieldStrategy() bool {
+	return a.EdgeFieldStrategy != BizPointerWithProtoMessage || a.EdgeStrategySet
+}
+
+// HasThroughStrategy 报告是否设置了 ThroughStrategy: 非零值，或经 WithThroughStrategy 显式设置
+func (a Annotation) HasThroughStrategy() bool {
+	return a.ThroughStrategy != ThroughPlainList || a.ThroughSet
+}
+
+// Merge 实现 schema.Merger 接口，other 中的非零值覆盖当前值
+func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
+	var o Annotation
+	switch v := other.(type) {
+	case Annotation:
+		o = v
+	case *Annotation:
+		if v == nil {
+			return a
+		}
+		o = *v
+	default:
+		return a
+	}
+	if o.EnumValues != nil {
+		a.EnumValues = o.EnumValues
+	}
+	if o.HasEdgeFieldStrategy() {
+		a.EdgeFieldStrategy = o.EdgeFieldStrategy
+		a.EdgeStrategySet = true
+	}
+	if o.HasThroughStrategy() {
+		a.ThroughStrategy = o.ThroughStrategy
+		a.ThroughSet = true
+	}
+	if o.EdgeCount {
+		a.EdgeCount = true
+	}
+	if o.BizExclude {
+		a.BizExclude = true
+	}
+	if o.ProtoExclude {
+		a.ProtoExclude = true
+	}
+	if o.ProtoReadOnly {
+		a.ProtoReadOnly = true
+	}
+	if o.VersionField {
+		a.VersionField = true
+	}
+	if o.VersionOptional {
+		a.VersionOptional = true
+	}
+	if o.SoftDelete {
+		a.SoftDelete = true
+	}
+	if o.SoftDeleteInProto {
+		a.SoftDeleteInProto = true
+	}
+	if o.Tenant {
+		a.Tenant = true
+	}
+	if o.VisibleTo != nil {
+		a.VisibleTo = o.VisibleTo
+	}
+	if o.CRUDMethods != nil {
+		a.CRUDMethods = o.CRUDMethods
+	}
+	if o.RepoMethods != nil {
+		a.RepoMethods = o.RepoMethods
+	}
+	if o.EdgeMethods != nil {
+		a.EdgeMethods = o.EdgeMethods
+	}
+	if o.BizName != "" {
+		a.BizName = o.BizName
+	}
+	if o.BizType != "" {
+		a.BizType = o.BizType
+	}
+	if o.ProtoName != "" {
+		a.ProtoName = o.ProtoName
+	}
+	if o.ProtoType != "" {
+		a.ProtoType = o.ProtoType
+	}
+	if o.ProtoFieldID != 0 {
+		a.ProtoFieldID = o.ProtoFieldID
+	}
+	if o.ProtoValidation != "" {
+		a.ProtoValidation = o.ProtoValidation
+	}
+	if o.Validation != nil {
+		a.Validation = o.Validation
+	}
+	if o.Resource != nil {
+		a.Resource = o.Resource
+	}
+	if o.Stream {
+		a.Stream = true
+	}
+	return a
+}
@@ -0,0 +1,11 @@
+package types
+
+// Resource 定义 Schema 的 AIP 资源名称 (https://google.aip.dev/122)
+// 资源名称由父资源名称与 {集合 ID}/{资源 ID} 组成，父资源由指向另一个资源的 Unique 反向 Edge (edge.From) 推导，
+// 例如 Post 经由 author 指向 User 时，Post 的资源模式为 users/{user}/posts/{post}
+type Resource struct {
+	Type       string `json:"type"`                 // 资源类型 (必填)，例如 "blog.example.com/Post"
+	Collection string `json:"collection,omitempty"` // 集合 ID，默认为 Schema 名称的 snake_case 复数，例如 posts
+	Parent     string `json:"parent,omitempty"`     // 父资源 Edge 名称，默认为第一个指向资源的 Unique 反向 Edge
+	NameField  string `json:"name_field,omitempty"` // 资源名称的 Proto 字段，替代 Proto 消息中的 ID 字段，默认为 name
+}
@@ -8,6 +8,7 @@ type StringRules = types.StringRules
 type NumberRules = types.NumberRules
 type RepeatedRules = types.RepeatedRules
 type EnumRules = types.EnumRules
+type Resource = types.Resource
 
 // WithEnumValues 设置枚举数值映射
 // key: 枚举名称 (例如 "ACTIVE"), value: 枚举值 (例如 1)
@@ -18,10 +19,169 @@ func WithEnumValues(values map[string]int32) Annotation {
 }
 
 // WithEdgeFieldStrategy 设置 Edge 字段的生成策略
-// 默认策略为 BizPointerWithProtoMessage
+// 用于 Schema 的 Annotations() 时作为该 Schema 所有 Edge 的默认策略
+// 未设置时回退到 Config.DefaultEdgeStrategy，最终默认为 BizPointerWithProtoMessage
 func WithEdgeFieldStrategy(strategy types.EdgeFieldStrategy) Annotation {
 	return Annotation{
 		EdgeFieldStrategy: strategy,
+		EdgeStrategySet:   true,
+	}
+}
+
+// WithThroughStrategy 设置经由 Edge Schema (edge.Through) 的 M2M Edge 的生成策略
+// 用于 Schema 的 Annotations() 时作为该 Schema 所有 Edge 的默认策略，最终默认为 ThroughPlainList
+func WithThroughStrategy(strategy types.EdgeThroughStrategy) Annotation {
+	return Annotation{
+		ThroughStrategy: strategy,
+		ThroughSet:      true,
+	}
+}
+
+// WithEdgeCount 为非 Unique Edge 生成关联数量字段
+// 例如 posts Edge 生成 Biz 字段 PostCount int 与 Proto 字段 int64 post_count，
+// 并在 Data 层生成批量填充数量的 FillUserPostCount 函数
+func WithEdgeCount() Annotation {
+	return Annotation{
+		EdgeCount: true,
+	}
+}
+
+// WithBizExclude 不为该字段生成 Biz 字段，Ent <-> Biz 映射时忽略
+// 由于 Proto 由 Biz 映射而来，该字段同样不会出现在 Proto 中
+func WithBizExclude() Annotation {
+	return Annotation{
+		BizExclude: true,
+	}
+}
+
+// WithProtoExclude 不为该字段生成 Proto 字段，Biz 字段及 Ent <-> Biz 映射保留
+func WithProtoExclude() Annotation {
+	return Annotation{
+		ProtoExclude: true,
+	}
+}
+
+// WithProtoReadOnly 将该字段标记为 Proto 只读
+// Biz -> Proto 时正常输出，Proto -> Biz 时忽略客户端传入的值
+func WithProtoReadOnly() Annotation {
+	return Annotation{
+		ProtoReadOnly: true,
+	}
+}
+
+// WithVersionField 将整数字段作为乐观锁版本号: 仓储 Update 仅在版本未变时更新并将版本加 1，
+// 版本不匹配时返回 biz.ErrConcurrentModification；UpdateXRequest 与 XPatch 携带期望的当前版本，客户端不能直接修改该字段。
+// UpdateXRequest 必须携带版本，未携带时返回参数错误，见 WithOptionalVersionField
+// 例如: field.Int("version").Default(0).Annotations(lazyent.WithVersionField())
+func WithVersionField() Annotation {
+	return Annotation{
+		VersionField: true,
+	}
+}
+
+// WithOptionalVersionField 同 WithVersionField，但 UpdateXRequest 可以不携带版本，此时以读取到的版本为准 (后写者胜)
+func WithOptionalVersionField() Annotation {
+	return Annotation{
+		VersionField:    true,
+		VersionOptional: true,
+	}
+}
+
+// WithSoftDelete 将 Optional time 字段 (通常为 Mixin 中的 deleted_at) 作为软删除时间:
+// 仓储查询、关联预加载与关联数量默认过滤已删除的行，Delete 改为设置该字段，并生成 Restore 仓储方法与 RestoreX RPC；
+// ListXsRequest 携带 include_deleted 以列出已删除的行。该字段默认不生成 Proto 字段，见 WithSoftDeleteInProto
+// 例如: field.Time("deleted_at").Optional().Annotations(lazyent.WithSoftDelete())
+func WithSoftDelete() Annotation {
+	return Annotation{
+		SoftDelete: true,
+	}
+}
+
+// WithSoftDeleteInProto 同 WithSoftDelete，并保留该字段的 Proto 字段 (只读)
+func WithSoftDeleteInProto() Annotation {
+	return Annotation{
+		SoftDelete:        true,
+		SoftDeleteInProto: true,
+	}
+}
+
+// WithTenant 将字段 (例如 tenant_id) 作为租户字段: 该字段不出现在任何 Proto 请求消息中，在 Proto 实体消息中只读 (Proto -> Biz 时忽略)，
+// 仓储的查询、更新、删除以及关联的遍历、预加载与数量总是限定为注入仓储构造函数的 biz.TenantResolver 返回的租户，创建时以其填充该字段；
+// ctx 中没有租户时，不带租户字段的实体仍可读取，但其带租户字段的关联实体不预加载、不计数
+// 例如: field.String("tenant_id").Immutable().Annotations(lazyent.WithTenant())
+func WithTenant() Annotation {
+	return Annotation{
+		Tenant: true,
+	}
+}
+
+// WithVisibleTo 限定可见该字段或 Edge 的角色: 生成的 service.BizXToProtoFor(role, b) 对
+// 不在 roles 中的角色清除对应的 Proto 字段 (BizXToProto 不受影响)，生成的 CRUD 服务回复与流式 RPC
+// 均经由 BizXToProtoFor 转换，角色由注入服务构造函数的 service.RoleResolver 给出
+// 例如: field.Float("score").Annotations(lazyent.WithVisibleTo(auth.RoleManager))
+func WithVisibleTo[R ~string](roles ...R) Annotation {
+	var a Annotation
+	for _, r := range roles {
+		a.VisibleTo = append(a.VisibleTo, string(r))
+	}
+	return a
+}
+
+// WithCRUDService 为 Schema 生成 CRUD gRPC 服务定义 (含请求/响应消息与 google.api.http 绑定)
+// 仅在 Schema 的 Annotations() 中有效，不指定方法时生成全部方法
+// 例如: lazyent.WithCRUDService(lazyent.CRUDCreate, lazyent.CRUDGet, lazyent.CRUDList)
+// RPC 实现生成在 services_gen.go 的 XServiceBase 中，仅生成一次的 x_service.go 嵌入它；
+// 旧版本生成的 x_service.go 自行实现了 RPC，升级时应删除后重新生成，或改为嵌入 XServiceBase 并删除未修改的方法
+func WithCRUDService(methods ...types.CRUDMethod) Annotation {
+	if len(methods) == 0 {
+		methods = types.AllCRUDMethods
+	}
+	return Annotation{
+		CRUDMethods: methods,
+	}
+}
+
+// WithRepo 为 Schema 在 Biz 层生成仓储接口 (例如 UserRepo)，由 Data 层实现
+// 仅在 Schema 的 Annotations() 中有效，不指定方法时生成全部方法，同时启用 WithCRUDService 时自动包含其方法所需的仓储方法
+// 例如: lazyent.WithRepo(lazyent.RepoFindByID, lazyent.RepoFindByUnique)
+func WithRepo(methods ...types.RepoMethod) Annotation {
+	if len(methods) == 0 {
+		methods = types.AllRepoMethods
+	}
+	return Annotation{
+		RepoMethods: methods,
+	}
+}
+
+// WithEdgeService 为非 Unique Edge 生成子资源操作: 所属 Schema 启用 WithCRUDService 时生成 RPC，启用 WithRepo 时生成仓储方法
+// 不指定方法时生成全部方法，Add/Remove 仅对 M2M Edge 生成
+// 例如: edge.To("users", User.Type).Annotations(lazyent.WithEdgeService(lazyent.EdgeList, lazyent.EdgeAdd))
+func WithEdgeService(methods ...types.EdgeMethod) Annotation {
+	if len(methods) == 0 {
+		methods = types.AllEdgeMethods
+	}
+	return Annotation{
+		EdgeMethods: methods,
+	}
+}
+
+// WithResource 将 Schema 声明为 AIP 资源: Proto 消息以资源名称字段 (默认为 name) 替代 ID 字段并带有 google.api.resource 选项，
+// Service 层生成 FormatXResourceName / ParseXResourceName 并在 Biz <-> Proto 转换中使用，
+// CRUD 服务的 Get/Update/Delete 等请求同样以资源名称定位，HTTP 路径形如 /v1/{name=users/*}
+// 仅在 Schema 的 Annotations() 中有效，ID 须为 UUID 或 string
+// 例如: lazyent.WithResource(&lazyent.Resource{Type: "blog.example.com/Post"})
+func WithResource(r *Resource) Annotation {
+	return Annotation{
+		Resource: r,
+	}
+}
+
+// WithStream 为 Schema 生成按 ID 分批读取的流式导出: 启用 WithCRUDService 时生成服务端流式 RPC (例如 StreamUsers)，
+// 启用 WithRepo 时生成返回 iter.Seq2 的仓储方法 Stream，每批最多读取 MaxPageSize 行
+// 仅在 Schema 的 Annotations() 中有效，流式 RPC 不生成 google.api.http 绑定
+func WithStream() Annotation {
+	return Annotation{
+		Stream: true,
 	}
 }
 
@@ -73,6 +233,8 @@ func WithProtoValidation(rules string) Annotation {
 }
 
 // WithValidation 设置结构化校验规则
+// 用于 Schema 的 Annotations() 或 entc.Annotations 时作为默认规则，按类型应用于未指定规则的字段
+// (例如 StringRules 仅应用于 string 字段)
 func WithValidation(rules *ValidationRules) Annotation {
 	return Annotation{
 		Validation: rules,
@@ -109,33 +271,7 @@ func ValidationEnum(r EnumRules) *ValidationRules {
 func MergeAnnotations(opts ...Annotation) Annotation {
 	merged := Annotation{}
 	for _, opt := range opts {
-		if opt.EnumValues != nil {
-			merged.EnumValues = opt.EnumValues
-		}
-		if opt.EdgeFieldStrategy != 0 {
-			merged.EdgeFieldStrategy = opt.EdgeFieldStrategy
-		}
-		if opt.BizName != "" {
-			merged.BizName = opt.BizName
-		}
-		if opt.BizType != "" {
-			merged.BizType = opt.BizType
-		}
-		if opt.ProtoName != "" {
-			merged.ProtoName = opt.ProtoName
-		}
-		if opt.ProtoType != "" {
-			merged.ProtoType = opt.ProtoType
-		}
-		if opt.ProtoFieldID != 0 {
-			merged.ProtoFieldID = opt.ProtoFieldID
-		}
-		if opt.ProtoValidation != "" {
-			merged.ProtoValidation = opt.ProtoValidation
-		}
-		if opt.Validation != nil {
-			merged.Validation = opt.Validation
-		}
+		merged = merged.Merge(opt).(Annotation)
 	}
 	return merged
 }
@@ -7,6 +7,11 @@ import (
 // Exported types
 type Annotation = types.Annotation
 type ProtoValidator = types.ProtoValidator
+type EdgeFieldStrategy = types.EdgeFieldStrategy
+type EdgeThroughStrategy = types.EdgeThroughStrategy
+type CRUDMethod = types.CRUDMethod
+type RepoMethod = types.RepoMethod
+type EdgeMethod = types.EdgeMethod
 
 const (
 	// ProtoValidatorNoValidator 不生成任何校验规则
@@ -31,3 +36,55 @@ const (
 	// BizExcludeWithProtoExclude 使用 Biz 排除和 Proto 排除
 	BizExcludeWithProtoExclude = types.BizExcludeWithProtoExclude
 )
+
+const (
+	// ThroughPlainList 经由 Edge Schema 的 M2M Edge 仅生成普通列表 (默认)
+	ThroughPlainList = types.ThroughPlainList
+	// ThroughAssociationList 经由 Edge Schema 的 M2M Edge 仅生成携带关联表字段的关联列表
+	ThroughAssociationList = types.ThroughAssociationList
+	// ThroughBoth 同时生成普通列表与关联列表
+	ThroughBoth = types.ThroughBoth
+)
+
+const (
+	// CRUDCreate 生成 CreateX 方法 (POST /v1/xs)
+	CRUDCreate = types.CRUDCreate
+	// CRUDGet 生成 GetX 方法 (GET /v1/xs/{id})
+	CRUDGet = types.CRUDGet
+	// CRUDGetByUnique 为每个唯一键 (Unique 字段或 Indexes 中的唯一索引) 生成 GetXByY 方法，例如 GetGroupByName (GET /v1/groups/by_name/{name})
+	CRUDGetByUnique = types.CRUDGetByUnique
+	// CRUDUpdate 生成 UpdateX 方法 (PUT /v1/xs/{id})
+	CRUDUpdate = types.CRUDUpdate
+	// CRUDDelete 生成 DeleteX 方法 (DELETE /v1/xs/{id})
+	CRUDDelete = types.CRUDDelete
+	// CRUDList 生成 ListXs 方法 (GET /v1/xs)
+	CRUDList = types.CRUDList
+)
+
+const (
+	// RepoSave 生成 Save(ctx, *X) (*X, error)
+	RepoSave = types.RepoSave
+	// RepoUpdate 生成 Update(ctx, *X, mask) (*X, error)
+	RepoUpdate = types.RepoUpdate
+	// RepoFindByID 生成 FindByID(ctx, id) (*X, error)
+	RepoFindByID = types.RepoFindByID
+	// RepoDelete 生成 Delete(ctx, id) error
+	RepoDelete = types.RepoDelete
+	// RepoListAll 生成 ListAll(ctx) ([]*X, error)
+	RepoListAll = types.RepoListAll
+	// RepoList 生成 List(ctx, *XListOptions) ([]*X, string, error)，支持分页、过滤与排序
+	RepoList = types.RepoList
+	// RepoFindByUnique 为每个唯一键 (Unique 字段或 Indexes 中的唯一索引) 生成 FindByX，例如 Group.name 生成 FindByName
+	RepoFindByUnique = types.RepoFindByUnique
+	// RepoListEdges 为每个非 Unique Edge 生成 ListXY，例如 User.posts 生成 ListUserPosts
+	RepoListEdges = types.RepoListEdges
+)
+
+const (
+	// EdgeList 生成分页列出关联对象的 ListXY 方法，例如 ListUserPosts (GET /v1/users/{id}/posts)
+	EdgeList = types.EdgeList
+	// EdgeAdd 生成添加关联的 AddXY 方法，例如 AddGroupUsers (POST /v1/groups/{id}/users:add)，仅 M2M Edge 有效
+	EdgeAdd = types.EdgeAdd
+	// EdgeRemove 生成移除关联的 RemoveXY 方法，例如 RemoveGroupUsers (POST /v1/groups/{id}/users:remove)，仅 M2M Edge 有效
+	EdgeRemove = types.EdgeRemove
+)